@@ -77,6 +77,8 @@ const (
 	GameEvent_PLAYER_LEAVED GameEvent = 2
 	GameEvent_MOVE_MADE     GameEvent = 3
 	GameEvent_GAME_OVER     GameEvent = 4
+	GameEvent_REACTION      GameEvent = 5 // A player reacted, the game itself didn't change
+	GameEvent_TIMED_OUT     GameEvent = 6 // The player to move ran out of time in a correspondence game
 )
 
 // Enum value maps for GameEvent.
@@ -87,6 +89,8 @@ var (
 		2: "PLAYER_LEAVED",
 		3: "MOVE_MADE",
 		4: "GAME_OVER",
+		5: "REACTION",
+		6: "TIMED_OUT",
 	}
 	GameEvent_value = map[string]int32{
 		"GAME_CREATED":  0,
@@ -94,6 +98,8 @@ var (
 		"PLAYER_LEAVED": 2,
 		"MOVE_MADE":     3,
 		"GAME_OVER":     4,
+		"REACTION":      5,
+		"TIMED_OUT":     6,
 	}
 )
 
@@ -124,6 +130,422 @@ func (GameEvent) EnumDescriptor() ([]byte, []int) {
 	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{1}
 }
 
+type ImageFormat int32
+
+const (
+	ImageFormat_PNG ImageFormat = 0
+	ImageFormat_GIF ImageFormat = 1
+)
+
+// Enum value maps for ImageFormat.
+var (
+	ImageFormat_name = map[int32]string{
+		0: "PNG",
+		1: "GIF",
+	}
+	ImageFormat_value = map[string]int32{
+		"PNG": 0,
+		"GIF": 1,
+	}
+)
+
+func (x ImageFormat) Enum() *ImageFormat {
+	p := new(ImageFormat)
+	*p = x
+	return p
+}
+
+func (x ImageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[2].Descriptor()
+}
+
+func (ImageFormat) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[2]
+}
+
+func (x ImageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageFormat.Descriptor instead.
+func (ImageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{2}
+}
+
+type NotificationType int32
+
+const (
+	NotificationType_CHALLENGE_RECEIVED         NotificationType = 0
+	NotificationType_CHALLENGE_ACCEPTED         NotificationType = 1
+	NotificationType_CHALLENGE_DECLINED         NotificationType = 2
+	NotificationType_FRIEND_REQUEST_RECEIVED    NotificationType = 3
+	NotificationType_FRIEND_REQUEST_ACCEPTED    NotificationType = 4
+	NotificationType_TOURNAMENT_GAME_READY      NotificationType = 5  // A game of a tournament match was created for the player
+	NotificationType_TOURNAMENT_STARTING        NotificationType = 6  // A tournament the player registered for starts at the deadline
+	NotificationType_TOURNAMENT_FORFEIT_WARNING NotificationType = 7  // The player forfeits their match unless they connect to their game by the deadline
+	NotificationType_YOUR_TURN                  NotificationType = 8  // It is the player's turn in a correspondence game
+	NotificationType_MOVE_REMINDER              NotificationType = 9  // The player loses a correspondence game unless they move by the deadline
+	NotificationType_GAME_FINISHED              NotificationType = 10 // A correspondence game of the player is over
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0:  "CHALLENGE_RECEIVED",
+		1:  "CHALLENGE_ACCEPTED",
+		2:  "CHALLENGE_DECLINED",
+		3:  "FRIEND_REQUEST_RECEIVED",
+		4:  "FRIEND_REQUEST_ACCEPTED",
+		5:  "TOURNAMENT_GAME_READY",
+		6:  "TOURNAMENT_STARTING",
+		7:  "TOURNAMENT_FORFEIT_WARNING",
+		8:  "YOUR_TURN",
+		9:  "MOVE_REMINDER",
+		10: "GAME_FINISHED",
+	}
+	NotificationType_value = map[string]int32{
+		"CHALLENGE_RECEIVED":         0,
+		"CHALLENGE_ACCEPTED":         1,
+		"CHALLENGE_DECLINED":         2,
+		"FRIEND_REQUEST_RECEIVED":    3,
+		"FRIEND_REQUEST_ACCEPTED":    4,
+		"TOURNAMENT_GAME_READY":      5,
+		"TOURNAMENT_STARTING":        6,
+		"TOURNAMENT_FORFEIT_WARNING": 7,
+		"YOUR_TURN":                  8,
+		"MOVE_REMINDER":              9,
+		"GAME_FINISHED":              10,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[3].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[3]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{3}
+}
+
+type Presence int32
+
+const (
+	Presence_OFFLINE Presence = 0
+	Presence_ONLINE  Presence = 1
+	Presence_IN_GAME Presence = 2
+)
+
+// Enum value maps for Presence.
+var (
+	Presence_name = map[int32]string{
+		0: "OFFLINE",
+		1: "ONLINE",
+		2: "IN_GAME",
+	}
+	Presence_value = map[string]int32{
+		"OFFLINE": 0,
+		"ONLINE":  1,
+		"IN_GAME": 2,
+	}
+)
+
+func (x Presence) Enum() *Presence {
+	p := new(Presence)
+	*p = x
+	return p
+}
+
+func (x Presence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Presence) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[4].Descriptor()
+}
+
+func (Presence) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[4]
+}
+
+func (x Presence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Presence.Descriptor instead.
+func (Presence) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{4}
+}
+
+type ChatChannel int32
+
+const (
+	ChatChannel_PLAYERS    ChatChannel = 0 // Seen by everyone following the game
+	ChatChannel_SPECTATORS ChatChannel = 1 // Seen by spectators only, so they can't help a player
+)
+
+// Enum value maps for ChatChannel.
+var (
+	ChatChannel_name = map[int32]string{
+		0: "PLAYERS",
+		1: "SPECTATORS",
+	}
+	ChatChannel_value = map[string]int32{
+		"PLAYERS":    0,
+		"SPECTATORS": 1,
+	}
+)
+
+func (x ChatChannel) Enum() *ChatChannel {
+	p := new(ChatChannel)
+	*p = x
+	return p
+}
+
+func (x ChatChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[5].Descriptor()
+}
+
+func (ChatChannel) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[5]
+}
+
+func (x ChatChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannel.Descriptor instead.
+func (ChatChannel) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{5}
+}
+
+type ReactionType int32
+
+const (
+	ReactionType_THUMBS_UP ReactionType = 0
+	ReactionType_SURPRISED ReactionType = 1
+	ReactionType_LAUGHING  ReactionType = 2
+	ReactionType_GOOD_MOVE ReactionType = 3
+	ReactionType_HURRY_UP  ReactionType = 4
+)
+
+// Enum value maps for ReactionType.
+var (
+	ReactionType_name = map[int32]string{
+		0: "THUMBS_UP",
+		1: "SURPRISED",
+		2: "LAUGHING",
+		3: "GOOD_MOVE",
+		4: "HURRY_UP",
+	}
+	ReactionType_value = map[string]int32{
+		"THUMBS_UP": 0,
+		"SURPRISED": 1,
+		"LAUGHING":  2,
+		"GOOD_MOVE": 3,
+		"HURRY_UP":  4,
+	}
+)
+
+func (x ReactionType) Enum() *ReactionType {
+	p := new(ReactionType)
+	*p = x
+	return p
+}
+
+func (x ReactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[6].Descriptor()
+}
+
+func (ReactionType) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[6]
+}
+
+func (x ReactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReactionType.Descriptor instead.
+func (ReactionType) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{6}
+}
+
+type TournamentFormat int32
+
+const (
+	TournamentFormat_ROUND_ROBIN TournamentFormat = 0 // Everyone plays everyone once
+	TournamentFormat_SWISS       TournamentFormat = 1 // Players with similar scores meet, for a set number of rounds
+	TournamentFormat_KNOCKOUT    TournamentFormat = 2 // Single elimination bracket
+)
+
+// Enum value maps for TournamentFormat.
+var (
+	TournamentFormat_name = map[int32]string{
+		0: "ROUND_ROBIN",
+		1: "SWISS",
+		2: "KNOCKOUT",
+	}
+	TournamentFormat_value = map[string]int32{
+		"ROUND_ROBIN": 0,
+		"SWISS":       1,
+		"KNOCKOUT":    2,
+	}
+)
+
+func (x TournamentFormat) Enum() *TournamentFormat {
+	p := new(TournamentFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[7].Descriptor()
+}
+
+func (TournamentFormat) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[7]
+}
+
+func (x TournamentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentFormat.Descriptor instead.
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{7}
+}
+
+type TournamentStatus int32
+
+const (
+	TournamentStatus_REGISTRATION TournamentStatus = 0
+	TournamentStatus_RUNNING      TournamentStatus = 1
+	TournamentStatus_COMPLETED    TournamentStatus = 2
+)
+
+// Enum value maps for TournamentStatus.
+var (
+	TournamentStatus_name = map[int32]string{
+		0: "REGISTRATION",
+		1: "RUNNING",
+		2: "COMPLETED",
+	}
+	TournamentStatus_value = map[string]int32{
+		"REGISTRATION": 0,
+		"RUNNING":      1,
+		"COMPLETED":    2,
+	}
+)
+
+func (x TournamentStatus) Enum() *TournamentStatus {
+	p := new(TournamentStatus)
+	*p = x
+	return p
+}
+
+func (x TournamentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[8].Descriptor()
+}
+
+func (TournamentStatus) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[8]
+}
+
+func (x TournamentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentStatus.Descriptor instead.
+func (TournamentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{8}
+}
+
+type Outcome int32
+
+const (
+	Outcome_DRAW Outcome = 0
+	Outcome_WIN  Outcome = 1
+	Outcome_LOSS Outcome = 2
+)
+
+// Enum value maps for Outcome.
+var (
+	Outcome_name = map[int32]string{
+		0: "DRAW",
+		1: "WIN",
+		2: "LOSS",
+	}
+	Outcome_value = map[string]int32{
+		"DRAW": 0,
+		"WIN":  1,
+		"LOSS": 2,
+	}
+)
+
+func (x Outcome) Enum() *Outcome {
+	p := new(Outcome)
+	*p = x
+	return p
+}
+
+func (x Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_tictactoe_game_proto_enumTypes[9].Descriptor()
+}
+
+func (Outcome) Type() protoreflect.EnumType {
+	return &file_api_tictactoe_game_proto_enumTypes[9]
+}
+
+func (x Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Outcome.Descriptor instead.
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{9}
+}
+
 type PlayerData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -231,7 +653,13 @@ type CreateGameRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"` // Game password
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`                                // Game password, empty for a public game
+	AllowHints    bool   `protobuf:"varint,2,opt,name=allow_hints,json=allowHints,proto3" json:"allow_hints,omitempty"`         // Allow position analysis while the game is in progress. Without, its players can analyze no 3x3 board until it ends
+	BoardSize     int32  `protobuf:"varint,3,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`            // Board width and height, 3 if unset
+	WinLength     int32  `protobuf:"varint,4,opt,name=win_length,json=winLength,proto3" json:"win_length,omitempty"`            // Pieces in a row needed to win
+	Bot           string `protobuf:"bytes,5,opt,name=bot,proto3" json:"bot,omitempty"`                                          // Bot difficulty to play against, empty for a human opponent
+	StartPosition string `protobuf:"bytes,6,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"` // Custom starting position in position notation, overrides the board shape
+	DaysPerMove   int32  `protobuf:"varint,7,opt,name=days_per_move,json=daysPerMove,proto3" json:"days_per_move,omitempty"`    // Days each side has for a move in a correspondence game, 0 for a live game
 }
 
 func (x *CreateGameRequest) Reset() {
@@ -273,6 +701,48 @@ func (x *CreateGameRequest) GetPassword() string {
 	return ""
 }
 
+func (x *CreateGameRequest) GetAllowHints() bool {
+	if x != nil {
+		return x.AllowHints
+	}
+	return false
+}
+
+func (x *CreateGameRequest) GetBoardSize() int32 {
+	if x != nil {
+		return x.BoardSize
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetWinLength() int32 {
+	if x != nil {
+		return x.WinLength
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetBot() string {
+	if x != nil {
+		return x.Bot
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetStartPosition() string {
+	if x != nil {
+		return x.StartPosition
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetDaysPerMove() int32 {
+	if x != nil {
+		return x.DaysPerMove
+	}
+	return 0
+}
+
 type JoinGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -482,15 +952,25 @@ type GameData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                            // Game id
-	Password      string      `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                                // Game password
-	Board         []string    `protobuf:"bytes,3,rep,name=board,proto3" json:"board,omitempty"`                                      // Board
-	CurrentPlayer *PlayerData `protobuf:"bytes,4,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"` // Player who move
-	Winner        string      `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`                                    // Winner
-	PlayerX       *PlayerData `protobuf:"bytes,6,opt,name=player_x,json=playerX,proto3" json:"player_x,omitempty"`                   // Player 1
-	PlayerO       *PlayerData `protobuf:"bytes,7,opt,name=player_o,json=playerO,proto3" json:"player_o,omitempty"`                   // Player 2
-	Status        GameStatus  `protobuf:"varint,8,opt,name=status,proto3,enum=game.GameStatus" json:"status,omitempty"`              // Status
-	Event         GameEvent   `protobuf:"varint,9,opt,name=event,proto3,enum=game.GameEvent" json:"event,omitempty"`                 // Event
+	Id            string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                             // Game id
+	Board         []string    `protobuf:"bytes,3,rep,name=board,proto3" json:"board,omitempty"`                                       // Board
+	CurrentPlayer *PlayerData `protobuf:"bytes,4,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`  // Player who move
+	Winner        string      `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`                                     // Winner
+	PlayerX       *PlayerData `protobuf:"bytes,6,opt,name=player_x,json=playerX,proto3" json:"player_x,omitempty"`                    // Player 1
+	PlayerO       *PlayerData `protobuf:"bytes,7,opt,name=player_o,json=playerO,proto3" json:"player_o,omitempty"`                    // Player 2
+	Status        GameStatus  `protobuf:"varint,8,opt,name=status,proto3,enum=game.GameStatus" json:"status,omitempty"`               // Status
+	Event         GameEvent   `protobuf:"varint,9,opt,name=event,proto3,enum=game.GameEvent" json:"event,omitempty"`                  // Event
+	AllowHints    bool        `protobuf:"varint,10,opt,name=allow_hints,json=allowHints,proto3" json:"allow_hints,omitempty"`         // Hints allowed during the game
+	Moves         []int32     `protobuf:"varint,11,rep,packed,name=moves,proto3" json:"moves,omitempty"`                              // Positions in the order they were played
+	BoardSize     int32       `protobuf:"varint,12,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`            // Board width and height
+	WinLength     int32       `protobuf:"varint,13,opt,name=win_length,json=winLength,proto3" json:"win_length,omitempty"`            // Pieces in a row needed to win
+	StartPosition string      `protobuf:"bytes,14,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"` // Starting position in position notation, empty for an empty board
+	Version       int64       `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`                                 // Incremented with every published update of the game
+	HasPassword   bool        `protobuf:"varint,16,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`      // Joining needs the password or an invite code
+	Reaction      *Reaction   `protobuf:"bytes,17,opt,name=reaction,proto3" json:"reaction,omitempty"`                                // Set when the event is REACTION
+	TournamentId  string      `protobuf:"bytes,18,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`    // Tournament the game is part of
+	DaysPerMove   int32       `protobuf:"varint,19,opt,name=days_per_move,json=daysPerMove,proto3" json:"days_per_move,omitempty"`    // Set for a correspondence game
+	MoveDeadline  int64       `protobuf:"varint,20,opt,name=move_deadline,json=moveDeadline,proto3" json:"move_deadline,omitempty"`   // Unix time the current player loses on time in a correspondence game
 }
 
 func (x *GameData) Reset() {
@@ -532,13 +1012,6 @@ func (x *GameData) GetId() string {
 	return ""
 }
 
-func (x *GameData) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
 func (x *GameData) GetBoard() []string {
 	if x != nil {
 		return x.Board
@@ -588,152 +1061,4633 @@ func (x *GameData) GetEvent() GameEvent {
 	return GameEvent_GAME_CREATED
 }
 
-var File_api_tictactoe_game_proto protoreflect.FileDescriptor
+func (x *GameData) GetAllowHints() bool {
+	if x != nil {
+		return x.AllowHints
+	}
+	return false
+}
 
-var file_api_tictactoe_game_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x2f,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x61, 0x6d, 0x65,
-	0x22, 0x4a, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x0c,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x46,
-	0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2b, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
-	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
-	0x65, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22,
-	0xc8, 0x02, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x37,
-	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12,
-	0x2b, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x58, 0x12, 0x2b, 0x0a, 0x08,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x43, 0x0a, 0x0a, 0x47, 0x61,
-	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54,
-	0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10,
-	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x2a,
-	0x61, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x0c,
-	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11,
-	0x0a, 0x0d, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x41, 0x56,
-	0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4d, 0x41, 0x44,
-	0x45, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52,
-	0x10, 0x04, 0x32, 0xcb, 0x02, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
-	0x65, 0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x08,
-	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22,
-	0x00, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x08, 0x4d, 0x61, 0x6b, 0x65,
-	0x4d, 0x6f, 0x76, 0x65, 0x12, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x30, 0x01,
-	0x42, 0x24, 0x5a, 0x22, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74,
-	0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x76, 0x31, 0x3b, 0x74, 0x69, 0x63, 0x74, 0x61,
-	0x63, 0x74, 0x6f, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GameData) GetMoves() []int32 {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
 }
 
-var (
-	file_api_tictactoe_game_proto_rawDescOnce sync.Once
-	file_api_tictactoe_game_proto_rawDescData = file_api_tictactoe_game_proto_rawDesc
-)
+func (x *GameData) GetBoardSize() int32 {
+	if x != nil {
+		return x.BoardSize
+	}
+	return 0
+}
 
-func file_api_tictactoe_game_proto_rawDescGZIP() []byte {
-	file_api_tictactoe_game_proto_rawDescOnce.Do(func() {
-		file_api_tictactoe_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_tictactoe_game_proto_rawDescData)
-	})
-	return file_api_tictactoe_game_proto_rawDescData
+func (x *GameData) GetWinLength() int32 {
+	if x != nil {
+		return x.WinLength
+	}
+	return 0
 }
 
-var file_api_tictactoe_game_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_tictactoe_game_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_api_tictactoe_game_proto_goTypes = []any{
-	(GameStatus)(0),           // 0: game.GameStatus
-	(GameEvent)(0),            // 1: game.GameEvent
-	(*PlayerData)(nil),        // 2: game.PlayerData
-	(*LoginRequest)(nil),      // 3: game.LoginRequest
-	(*CreateGameRequest)(nil), // 4: game.CreateGameRequest
-	(*JoinGameRequest)(nil),   // 5: game.JoinGameRequest
-	(*LeaveGameRequest)(nil),  // 6: game.LeaveGameRequest
-	(*MoveRequest)(nil),       // 7: game.MoveRequest
-	(*GameRequest)(nil),       // 8: game.GameRequest
-	(*GameData)(nil),          // 9: game.GameData
+func (x *GameData) GetStartPosition() string {
+	if x != nil {
+		return x.StartPosition
+	}
+	return ""
 }
-var file_api_tictactoe_game_proto_depIdxs = []int32{
-	2,  // 0: game.GameData.current_player:type_name -> game.PlayerData
-	2,  // 1: game.GameData.player_x:type_name -> game.PlayerData
-	2,  // 2: game.GameData.player_o:type_name -> game.PlayerData
-	0,  // 3: game.GameData.status:type_name -> game.GameStatus
-	1,  // 4: game.GameData.event:type_name -> game.GameEvent
-	3,  // 5: game.GameService.Login:input_type -> game.LoginRequest
-	4,  // 6: game.GameService.CreateGame:input_type -> game.CreateGameRequest
-	5,  // 7: game.GameService.JoinGame:input_type -> game.JoinGameRequest
-	6,  // 8: game.GameService.LeaveGame:input_type -> game.LeaveGameRequest
-	7,  // 9: game.GameService.MakeMove:input_type -> game.MoveRequest
-	8,  // 10: game.GameService.GetGameState:input_type -> game.GameRequest
-	2,  // 11: game.GameService.Login:output_type -> game.PlayerData
-	9,  // 12: game.GameService.CreateGame:output_type -> game.GameData
-	9,  // 13: game.GameService.JoinGame:output_type -> game.GameData
-	9,  // 14: game.GameService.LeaveGame:output_type -> game.GameData
-	9,  // 15: game.GameService.MakeMove:output_type -> game.GameData
-	9,  // 16: game.GameService.GetGameState:output_type -> game.GameData
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+
+func (x *GameData) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func init() { file_api_tictactoe_game_proto_init() }
-func file_api_tictactoe_game_proto_init() {
-	if File_api_tictactoe_game_proto != nil {
-		return
+func (x *GameData) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_tictactoe_game_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*PlayerData); i {
+	return false
+}
+
+func (x *GameData) GetReaction() *Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *GameData) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GameData) GetDaysPerMove() int32 {
+	if x != nil {
+		return x.DaysPerMove
+	}
+	return 0
+}
+
+func (x *GameData) GetMoveDeadline() int64 {
+	if x != nil {
+		return x.MoveDeadline
+	}
+	return 0
+}
+
+type AnalyzePositionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // Game to analyze, takes precedence over board
+	Board    []string `protobuf:"bytes,2,rep,name=board,proto3" json:"board,omitempty"`                 // Board to analyze when no game is given
+	Position string   `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`           // Position notation to analyze, takes precedence over board
+}
+
+func (x *AnalyzePositionRequest) Reset() {
+	*x = AnalyzePositionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzePositionRequest) ProtoMessage() {}
+
+func (x *AnalyzePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzePositionRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzePositionRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AnalyzePositionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AnalyzePositionRequest) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *AnalyzePositionRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type CellEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int32   `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`                 // Empty cell
+	Outcome  Outcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=game.Outcome" json:"outcome,omitempty"` // Result for the side to move after playing here
+	Distance int32   `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`                 // Plies until the game ends with perfect play
+}
+
+func (x *CellEvaluation) Reset() {
+	*x = CellEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellEvaluation) ProtoMessage() {}
+
+func (x *CellEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellEvaluation.ProtoReflect.Descriptor instead.
+func (*CellEvaluation) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CellEvaluation) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CellEvaluation) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_DRAW
+}
+
+func (x *CellEvaluation) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type PositionAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*CellEvaluation `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"` // Evaluation of every empty cell
+}
+
+func (x *PositionAnalysis) Reset() {
+	*x = PositionAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionAnalysis) ProtoMessage() {}
+
+func (x *PositionAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionAnalysis.ProtoReflect.Descriptor instead.
+func (*PositionAnalysis) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PositionAnalysis) GetCells() []*CellEvaluation {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type ListBotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBotsRequest) Reset() {
+	*x = ListBotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBotsRequest) ProtoMessage() {}
+
+func (x *ListBotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBotsRequest.ProtoReflect.Descriptor instead.
+func (*ListBotsRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{11}
+}
+
+type BotList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // Bot difficulties that can be passed to CreateGame
+}
+
+func (x *BotList) Reset() {
+	*x = BotList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BotList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotList) ProtoMessage() {}
+
+func (x *BotList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotList.ProtoReflect.Descriptor instead.
+func (*BotList) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BotList) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetPuzzleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPuzzleRequest) Reset() {
+	*x = GetPuzzleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPuzzleRequest) ProtoMessage() {}
+
+func (x *GetPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*GetPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{13}
+}
+
+type Puzzle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PuzzleId     string   `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`              // Puzzle id
+	Board        []string `protobuf:"bytes,2,rep,name=board,proto3" json:"board,omitempty"`                                    // Position to solve, the side to move has a forced win
+	BoardSize    int32    `protobuf:"varint,3,opt,name=board_size,json=boardSize,proto3" json:"board_size,omitempty"`          // Board width and height
+	WinLength    int32    `protobuf:"varint,4,opt,name=win_length,json=winLength,proto3" json:"win_length,omitempty"`          // Pieces in a row needed to win
+	MovesToWin   int32    `protobuf:"varint,5,opt,name=moves_to_win,json=movesToWin,proto3" json:"moves_to_win,omitempty"`     // Moves of the side to move until the win
+	Rating       int32    `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`                                 // Puzzle difficulty
+	PlayerRating int32    `protobuf:"varint,7,opt,name=player_rating,json=playerRating,proto3" json:"player_rating,omitempty"` // Puzzle rating of the requesting player
+}
+
+func (x *Puzzle) Reset() {
+	*x = Puzzle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Puzzle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Puzzle) ProtoMessage() {}
+
+func (x *Puzzle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Puzzle.ProtoReflect.Descriptor instead.
+func (*Puzzle) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Puzzle) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *Puzzle) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *Puzzle) GetBoardSize() int32 {
+	if x != nil {
+		return x.BoardSize
+	}
+	return 0
+}
+
+func (x *Puzzle) GetWinLength() int32 {
+	if x != nil {
+		return x.WinLength
+	}
+	return 0
+}
+
+func (x *Puzzle) GetMovesToWin() int32 {
+	if x != nil {
+		return x.MovesToWin
+	}
+	return 0
+}
+
+func (x *Puzzle) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Puzzle) GetPlayerRating() int32 {
+	if x != nil {
+		return x.PlayerRating
+	}
+	return 0
+}
+
+type SubmitPuzzleSolutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PuzzleId string `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"` // Puzzle from GetPuzzle
+	Position int32  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`                // First move of the solution
+}
+
+func (x *SubmitPuzzleSolutionRequest) Reset() {
+	*x = SubmitPuzzleSolutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitPuzzleSolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPuzzleSolutionRequest) ProtoMessage() {}
+
+func (x *SubmitPuzzleSolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPuzzleSolutionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPuzzleSolutionRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubmitPuzzleSolutionRequest) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *SubmitPuzzleSolutionRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type PuzzleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correct      bool  `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`                               // Whether the move was the winning one
+	Solution     int32 `protobuf:"varint,2,opt,name=solution,proto3" json:"solution,omitempty"`                             // The winning move
+	PlayerRating int32 `protobuf:"varint,3,opt,name=player_rating,json=playerRating,proto3" json:"player_rating,omitempty"` // Puzzle rating of the player after the attempt
+	RatingChange int32 `protobuf:"varint,4,opt,name=rating_change,json=ratingChange,proto3" json:"rating_change,omitempty"` // Change of the player's rating
+}
+
+func (x *PuzzleResult) Reset() {
+	*x = PuzzleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuzzleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleResult) ProtoMessage() {}
+
+func (x *PuzzleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleResult.ProtoReflect.Descriptor instead.
+func (*PuzzleResult) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PuzzleResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *PuzzleResult) GetSolution() int32 {
+	if x != nil {
+		return x.Solution
+	}
+	return 0
+}
+
+func (x *PuzzleResult) GetPlayerRating() int32 {
+	if x != nil {
+		return x.PlayerRating
+	}
+	return 0
+}
+
+func (x *PuzzleResult) GetRatingChange() int32 {
+	if x != nil {
+		return x.RatingChange
+	}
+	return 0
+}
+
+type ExportGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // Finished game to export
+}
+
+func (x *ExportGameRequest) Reset() {
+	*x = ExportGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameRequest) ProtoMessage() {}
+
+func (x *ExportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameRequest.ProtoReflect.Descriptor instead.
+func (*ExportGameRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GameRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // Game in record notation
+}
+
+func (x *GameRecord) Reset() {
+	*x = GameRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRecord) ProtoMessage() {}
+
+func (x *GameRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRecord.ProtoReflect.Descriptor instead.
+func (*GameRecord) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GameRecord) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ImportGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // Game in record notation
+}
+
+func (x *ImportGameRequest) Reset() {
+	*x = ImportGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameRequest) ProtoMessage() {}
+
+func (x *ImportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameRequest.ProtoReflect.Descriptor instead.
+func (*ImportGameRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportGameRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type RenderGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId      string      `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                   // Game to render
+	Format      ImageFormat `protobuf:"varint,2,opt,name=format,proto3,enum=game.ImageFormat" json:"format,omitempty"`          // PNG of the current board or GIF of the whole game
+	CellSize    int32       `protobuf:"varint,3,opt,name=cell_size,json=cellSize,proto3" json:"cell_size,omitempty"`            // Cell width in pixels, 96 if unset
+	MoveDelayMs int32       `protobuf:"varint,4,opt,name=move_delay_ms,json=moveDelayMs,proto3" json:"move_delay_ms,omitempty"` // Time each move is shown in a GIF, 800 if unset
+}
+
+func (x *RenderGameRequest) Reset() {
+	*x = RenderGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderGameRequest) ProtoMessage() {}
+
+func (x *RenderGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderGameRequest.ProtoReflect.Descriptor instead.
+func (*RenderGameRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RenderGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RenderGameRequest) GetFormat() ImageFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImageFormat_PNG
+}
+
+func (x *RenderGameRequest) GetCellSize() int32 {
+	if x != nil {
+		return x.CellSize
+	}
+	return 0
+}
+
+func (x *RenderGameRequest) GetMoveDelayMs() int32 {
+	if x != nil {
+		return x.MoveDelayMs
+	}
+	return 0
+}
+
+type RenderedImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                                  // Encoded image
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME type of the image
+}
+
+func (x *RenderedImage) Reset() {
+	*x = RenderedImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderedImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderedImage) ProtoMessage() {}
+
+func (x *RenderedImage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderedImage.ProtoReflect.Descriptor instead.
+func (*RenderedImage) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RenderedImage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RenderedImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GetInviteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // Game created by the caller
+}
+
+func (x *GetInviteRequest) Reset() {
+	*x = GetInviteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteRequest) ProtoMessage() {}
+
+func (x *GetInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteRequest.ProtoReflect.Descriptor instead.
+func (*GetInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetInviteRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type Invite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId            string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                                       // Game to join
+	JoinCode          string `protobuf:"bytes,3,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`                                 // Short code for JoinByCode, lets the holder join without the password
+	JoinCodeExpiresAt int64  `protobuf:"varint,4,opt,name=join_code_expires_at,json=joinCodeExpiresAt,proto3" json:"join_code_expires_at,omitempty"` // Unix time when the join code stops working
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Invite) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Invite) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *Invite) GetJoinCodeExpiresAt() int64 {
+	if x != nil {
+		return x.JoinCodeExpiresAt
+	}
+	return 0
+}
+
+type RevokeJoinCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // Game created by the caller
+}
+
+func (x *RevokeJoinCodeRequest) Reset() {
+	*x = RevokeJoinCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeJoinCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeJoinCodeRequest) ProtoMessage() {}
+
+func (x *RevokeJoinCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeJoinCodeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeJoinCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeJoinCodeRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type RevokeJoinCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeJoinCodeResponse) Reset() {
+	*x = RevokeJoinCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeJoinCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeJoinCodeResponse) ProtoMessage() {}
+
+func (x *RevokeJoinCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeJoinCodeResponse.ProtoReflect.Descriptor instead.
+func (*RevokeJoinCodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{25}
+}
+
+type JoinByCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Join code from GetInvite, case insensitive
+}
+
+func (x *JoinByCodeRequest) Reset() {
+	*x = JoinByCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByCodeRequest) ProtoMessage() {}
+
+func (x *JoinByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByCodeRequest.ProtoReflect.Descriptor instead.
+func (*JoinByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JoinByCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ChallengePlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string             `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Player to challenge
+	Settings *CreateGameRequest `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`                 // Settings of the game, without bot or password
+}
+
+func (x *ChallengePlayerRequest) Reset() {
+	*x = ChallengePlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChallengePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengePlayerRequest) ProtoMessage() {}
+
+func (x *ChallengePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengePlayerRequest.ProtoReflect.Descriptor instead.
+func (*ChallengePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChallengePlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ChallengePlayerRequest) GetSettings() *CreateGameRequest {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type Challenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeId string             `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"` // Challenge id
+	From        *PlayerData        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                  // Player who sent the challenge and plays X
+	To          *PlayerData        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                      // Challenged player
+	Settings    *CreateGameRequest `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`                          // Settings of the game
+	GameId      string             `protobuf:"bytes,5,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                // Game created when the challenge was accepted
+	ExpiresAt   int64              `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Unix time when the challenge can no longer be accepted
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Challenge) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *Challenge) GetFrom() *PlayerData {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Challenge) GetTo() *PlayerData {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Challenge) GetSettings() *CreateGameRequest {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Challenge) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Challenge) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type AcceptChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"` // Challenge received by the caller
+}
+
+func (x *AcceptChallengeRequest) Reset() {
+	*x = AcceptChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptChallengeRequest) ProtoMessage() {}
+
+func (x *AcceptChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptChallengeRequest.ProtoReflect.Descriptor instead.
+func (*AcceptChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AcceptChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type DeclineChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"` // Challenge received, or sent to withdraw it
+}
+
+func (x *DeclineChallengeRequest) Reset() {
+	*x = DeclineChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineChallengeRequest) ProtoMessage() {}
+
+func (x *DeclineChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineChallengeRequest.ProtoReflect.Descriptor instead.
+func (*DeclineChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeclineChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type NotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NotificationsRequest) Reset() {
+	*x = NotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsRequest) ProtoMessage() {}
+
+func (x *NotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsRequest.ProtoReflect.Descriptor instead.
+func (*NotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{31}
+}
+
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         NotificationType `protobuf:"varint,1,opt,name=type,proto3,enum=game.NotificationType" json:"type,omitempty"`         // What happened
+	Challenge    *Challenge       `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`                           // Challenge the notification is about
+	Game         *GameData        `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`                                     // Game created when a challenge was accepted
+	Player       *PlayerData      `protobuf:"bytes,4,opt,name=player,proto3" json:"player,omitempty"`                                 // Player who sent or accepted a friend request
+	TournamentId string           `protobuf:"bytes,5,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"` // Tournament the notification is about
+	Deadline     int64            `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`                            // Unix time of the tournament start, forfeit or move deadline in a reminder
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_CHALLENGE_RECEIVED
+}
+
+func (x *Notification) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *Notification) GetGame() *GameData {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *Notification) GetPlayer() *PlayerData {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Notification) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *Notification) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type SendFriendRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Player to befriend
+}
+
+func (x *SendFriendRequestRequest) Reset() {
+	*x = SendFriendRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestRequest) ProtoMessage() {}
+
+func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SendFriendRequestRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type SendFriendRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"` // The player had asked to be friends too, so now you are
+}
+
+func (x *SendFriendRequestResponse) Reset() {
+	*x = SendFriendRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestResponse) ProtoMessage() {}
+
+func (x *SendFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SendFriendRequestResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type AnswerFriendRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Player who sent the request
+	Accept   bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`                    // Become friends, or decline
+}
+
+func (x *AnswerFriendRequestRequest) Reset() {
+	*x = AnswerFriendRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerFriendRequestRequest) ProtoMessage() {}
+
+func (x *AnswerFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*AnswerFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AnswerFriendRequestRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *AnswerFriendRequestRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type AnswerFriendRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AnswerFriendRequestResponse) Reset() {
+	*x = AnswerFriendRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerFriendRequestResponse) ProtoMessage() {}
+
+func (x *AnswerFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*AnswerFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{36}
+}
+
+type ListFriendRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFriendRequestsRequest) Reset() {
+	*x = ListFriendRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendRequestsRequest) ProtoMessage() {}
+
+func (x *ListFriendRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{37}
+}
+
+type FriendRequestList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*PlayerData `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"` // Players waiting for an answer
+}
+
+func (x *FriendRequestList) Reset() {
+	*x = FriendRequestList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendRequestList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestList) ProtoMessage() {}
+
+func (x *FriendRequestList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestList.ProtoReflect.Descriptor instead.
+func (*FriendRequestList) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FriendRequestList) GetPlayers() []*PlayerData {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type RemoveFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Friend to remove
+}
+
+func (x *RemoveFriendRequest) Reset() {
+	*x = RemoveFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRequest) ProtoMessage() {}
+
+func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemoveFriendRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type RemoveFriendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveFriendResponse) Reset() {
+	*x = RemoveFriendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendResponse) ProtoMessage() {}
+
+func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFriendResponse) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{40}
+}
+
+type ListFriendsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFriendsRequest) Reset() {
+	*x = ListFriendsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsRequest) ProtoMessage() {}
+
+func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{41}
+}
+
+type Friend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player   *PlayerData `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`                         // The friend
+	Presence Presence    `protobuf:"varint,2,opt,name=presence,proto3,enum=game.Presence" json:"presence,omitempty"` // Whether the friend is around to play
+	Removed  bool        `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`                      // Set in presence updates when the friendship ended
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Friend) GetPlayer() *PlayerData {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Friend) GetPresence() Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return Presence_OFFLINE
+}
+
+func (x *Friend) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type FriendList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"` // Friends of the player
+}
+
+func (x *FriendList) Reset() {
+	*x = FriendList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendList) ProtoMessage() {}
+
+func (x *FriendList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendList.ProtoReflect.Descriptor instead.
+func (*FriendList) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FriendList) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{44}
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalSeconds int32 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // Send the next heartbeat within this time to stay online
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HeartbeatResponse) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type SubscribePresenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribePresenceRequest) Reset() {
+	*x = SubscribePresenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePresenceRequest) ProtoMessage() {}
+
+func (x *SubscribePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePresenceRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{46}
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string      `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	GameId    string      `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	From      *PlayerData `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                              // Player or spectator who sent the message
+	Channel   ChatChannel `protobuf:"varint,4,opt,name=channel,proto3,enum=game.ChatChannel" json:"channel,omitempty"` // PLAYERS when sent by a player of the game
+	Text      string      `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`                              // Text after filtering
+	SentAt    int64       `protobuf:"varint,6,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`           // Unix time when the message was sent
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChatMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetFrom() *PlayerData {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_PLAYERS
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Text   string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // Message text, at most 200 characters
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SendChatMessageRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type GetChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetChatRequest) Reset() {
+	*x = GetChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatRequest) ProtoMessage() {}
+
+func (x *GetChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatRequest.ProtoReflect.Descriptor instead.
+func (*GetChatRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetChatRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type ReactionType `protobuf:"varint,1,opt,name=type,proto3,enum=game.ReactionType" json:"type,omitempty"`
+	From *PlayerData  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // Player who reacted
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Reaction) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_THUMBS_UP
+}
+
+func (x *Reaction) GetFrom() *PlayerData {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+type SendReactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string       `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Type   ReactionType `protobuf:"varint,2,opt,name=type,proto3,enum=game.ReactionType" json:"type,omitempty"`
+}
+
+func (x *SendReactionRequest) Reset() {
+	*x = SendReactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendReactionRequest) ProtoMessage() {}
+
+func (x *SendReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendReactionRequest.ProtoReflect.Descriptor instead.
+func (*SendReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SendReactionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SendReactionRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_THUMBS_UP
+}
+
+type SendReactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendReactionResponse) Reset() {
+	*x = SendReactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendReactionResponse) ProtoMessage() {}
+
+func (x *SendReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendReactionResponse.ProtoReflect.Descriptor instead.
+func (*SendReactionResponse) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{52}
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format               TournamentFormat   `protobuf:"varint,2,opt,name=format,proto3,enum=game.TournamentFormat" json:"format,omitempty"`
+	Settings             *CreateGameRequest `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`                                                        // Settings of every game, bot and password are not used
+	BestOf               int32              `protobuf:"varint,4,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`                                             // Games per match, odd, 1 when not set
+	Rounds               int32              `protobuf:"varint,5,opt,name=rounds,proto3" json:"rounds,omitempty"`                                                           // Rounds of a Swiss tournament, enough to find a winner when not set
+	RegistrationOpensAt  int64              `protobuf:"varint,6,opt,name=registration_opens_at,json=registrationOpensAt,proto3" json:"registration_opens_at,omitempty"`    // Unix time, open right away when not set
+	RegistrationClosesAt int64              `protobuf:"varint,7,opt,name=registration_closes_at,json=registrationClosesAt,proto3" json:"registration_closes_at,omitempty"` // Unix time, open until the start when not set
+	StartsAt             int64              `protobuf:"varint,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                                       // Unix time the tournament starts by itself, started by the creator when not set
+	NoShowMinutes        int32              `protobuf:"varint,9,opt,name=no_show_minutes,json=noShowMinutes,proto3" json:"no_show_minutes,omitempty"`                      // Players who don't connect to their game this long after a round starts forfeit, never when not set
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_ROUND_ROBIN
+}
+
+func (x *CreateTournamentRequest) GetSettings() *CreateGameRequest {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetRegistrationOpensAt() int64 {
+	if x != nil {
+		return x.RegistrationOpensAt
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetRegistrationClosesAt() int64 {
+	if x != nil {
+		return x.RegistrationClosesAt
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetNoShowMinutes() int32 {
+	if x != nil {
+		return x.NoShowMinutes
+	}
+	return 0
+}
+
+type TournamentMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round    int32       `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	PlayerX  *PlayerData `protobuf:"bytes,2,opt,name=player_x,json=playerX,proto3" json:"player_x,omitempty"` // X in the first game of the match, then colors alternate
+	PlayerO  *PlayerData `protobuf:"bytes,3,opt,name=player_o,json=playerO,proto3" json:"player_o,omitempty"` // Not set for a bye
+	GameIds  []string    `protobuf:"bytes,4,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"` // Games played so far, the last one may be in progress
+	WinsX    int32       `protobuf:"varint,5,opt,name=wins_x,json=winsX,proto3" json:"wins_x,omitempty"`
+	WinsO    int32       `protobuf:"varint,6,opt,name=wins_o,json=winsO,proto3" json:"wins_o,omitempty"`
+	Draws    int32       `protobuf:"varint,7,opt,name=draws,proto3" json:"draws,omitempty"`
+	Finished bool        `protobuf:"varint,8,opt,name=finished,proto3" json:"finished,omitempty"`
+	Winner   *PlayerData `protobuf:"bytes,9,opt,name=winner,proto3" json:"winner,omitempty"`     // Not set while playing or for a drawn match
+	Forfeit  bool        `protobuf:"varint,10,opt,name=forfeit,proto3" json:"forfeit,omitempty"` // Ended because a player didn't show up
+}
+
+func (x *TournamentMatch) Reset() {
+	*x = TournamentMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentMatch) ProtoMessage() {}
+
+func (x *TournamentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentMatch.ProtoReflect.Descriptor instead.
+func (*TournamentMatch) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TournamentMatch) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetPlayerX() *PlayerData {
+	if x != nil {
+		return x.PlayerX
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetPlayerO() *PlayerData {
+	if x != nil {
+		return x.PlayerO
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetWinsX() int32 {
+	if x != nil {
+		return x.WinsX
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetWinsO() int32 {
+	if x != nil {
+		return x.WinsO
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *TournamentMatch) GetWinner() *PlayerData {
+	if x != nil {
+		return x.Winner
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetForfeit() bool {
+	if x != nil {
+		return x.Forfeit
+	}
+	return false
+}
+
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format               TournamentFormat   `protobuf:"varint,3,opt,name=format,proto3,enum=game.TournamentFormat" json:"format,omitempty"`
+	Status               TournamentStatus   `protobuf:"varint,4,opt,name=status,proto3,enum=game.TournamentStatus" json:"status,omitempty"`
+	Creator              *PlayerData        `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`                                                           // Player who can start the tournament
+	Settings             *CreateGameRequest `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`                                                         // Settings of every game
+	BestOf               int32              `protobuf:"varint,7,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`                                              // Games per match
+	Rounds               int32              `protobuf:"varint,8,opt,name=rounds,proto3" json:"rounds,omitempty"`                                                            // Rounds to play, known once the tournament started
+	CurrentRound         int32              `protobuf:"varint,9,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"`                            // 0 before the start
+	Players              []*PlayerData      `protobuf:"bytes,10,rep,name=players,proto3" json:"players,omitempty"`                                                          // In registration order, which is also the seeding
+	Matches              []*TournamentMatch `protobuf:"bytes,11,rep,name=matches,proto3" json:"matches,omitempty"`                                                          // Matches of all rounds so far
+	RegistrationOpensAt  int64              `protobuf:"varint,12,opt,name=registration_opens_at,json=registrationOpensAt,proto3" json:"registration_opens_at,omitempty"`    // Unix time, 0 when not set
+	RegistrationClosesAt int64              `protobuf:"varint,13,opt,name=registration_closes_at,json=registrationClosesAt,proto3" json:"registration_closes_at,omitempty"` // Unix time, 0 when not set
+	StartsAt             int64              `protobuf:"varint,14,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                                       // Unix time of the scheduled start, 0 when not set
+	NoShowMinutes        int32              `protobuf:"varint,15,opt,name=no_show_minutes,json=noShowMinutes,proto3" json:"no_show_minutes,omitempty"`                      // 0 when no-shows don't forfeit
+	RoundStartedAt       int64              `protobuf:"varint,16,opt,name=round_started_at,json=roundStartedAt,proto3" json:"round_started_at,omitempty"`                   // Unix time the current round started
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_ROUND_ROBIN
+}
+
+func (x *Tournament) GetStatus() TournamentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TournamentStatus_REGISTRATION
+}
+
+func (x *Tournament) GetCreator() *PlayerData {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *Tournament) GetSettings() *CreateGameRequest {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Tournament) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *Tournament) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *Tournament) GetCurrentRound() int32 {
+	if x != nil {
+		return x.CurrentRound
+	}
+	return 0
+}
+
+func (x *Tournament) GetPlayers() []*PlayerData {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Tournament) GetMatches() []*TournamentMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *Tournament) GetRegistrationOpensAt() int64 {
+	if x != nil {
+		return x.RegistrationOpensAt
+	}
+	return 0
+}
+
+func (x *Tournament) GetRegistrationClosesAt() int64 {
+	if x != nil {
+		return x.RegistrationClosesAt
+	}
+	return 0
+}
+
+func (x *Tournament) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *Tournament) GetNoShowMinutes() int32 {
+	if x != nil {
+		return x.NoShowMinutes
+	}
+	return 0
+}
+
+func (x *Tournament) GetRoundStartedAt() int64 {
+	if x != nil {
+		return x.RoundStartedAt
+	}
+	return 0
+}
+
+type ListTournamentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTournamentsRequest) Reset() {
+	*x = ListTournamentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTournamentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTournamentsRequest) ProtoMessage() {}
+
+func (x *ListTournamentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTournamentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTournamentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{56}
+}
+
+type TournamentList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournaments []*Tournament `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"` // Newest first, without their matches
+}
+
+func (x *TournamentList) Reset() {
+	*x = TournamentList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentList) ProtoMessage() {}
+
+func (x *TournamentList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentList.ProtoReflect.Descriptor instead.
+func (*TournamentList) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TournamentList) GetTournaments() []*Tournament {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+type RegisterForTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *RegisterForTournamentRequest) Reset() {
+	*x = RegisterForTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterForTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterForTournamentRequest) ProtoMessage() {}
+
+func (x *RegisterForTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterForTournamentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterForTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RegisterForTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type StartTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *StartTournamentRequest) Reset() {
+	*x = StartTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTournamentRequest) ProtoMessage() {}
+
+func (x *StartTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTournamentRequest.ProtoReflect.Descriptor instead.
+func (*StartTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *StartTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type GetStandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *GetStandingsRequest) Reset() {
+	*x = GetStandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsRequest) ProtoMessage() {}
+
+func (x *GetStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetStandingsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type SubscribeStandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *SubscribeStandingsRequest) Reset() {
+	*x = SubscribeStandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeStandingsRequest) ProtoMessage() {}
+
+func (x *SubscribeStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeStandingsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SubscribeStandingsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank            int32       `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Player          *PlayerData `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Score           float64     `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`                                            // 1 for a won match or a bye, 0.5 for a drawn match
+	Buchholz        float64     `protobuf:"fixed64,4,opt,name=buchholz,proto3" json:"buchholz,omitempty"`                                      // Sum of the scores of the opponents, a bye counting as an opponent with 1
+	SonnebornBerger float64     `protobuf:"fixed64,5,opt,name=sonneborn_berger,json=sonnebornBerger,proto3" json:"sonneborn_berger,omitempty"` // Scores of the opponents beaten, plus half of those drawn, a bye counting as beating an opponent with 1
+	Wins            int32       `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`                                               // Matches won, byes not included
+	Draws           int32       `protobuf:"varint,7,opt,name=draws,proto3" json:"draws,omitempty"`
+	Losses          int32       `protobuf:"varint,8,opt,name=losses,proto3" json:"losses,omitempty"`
+	Eliminated      bool        `protobuf:"varint,9,opt,name=eliminated,proto3" json:"eliminated,omitempty"` // Lost a knockout match
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Standing) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Standing) GetPlayer() *PlayerData {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Standing) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Standing) GetBuchholz() float64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+func (x *Standing) GetSonnebornBerger() float64 {
+	if x != nil {
+		return x.SonnebornBerger
+	}
+	return 0
+}
+
+func (x *Standing) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Standing) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *Standing) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Standing) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
+type Standings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournament *Tournament `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Standings  []*Standing `protobuf:"bytes,2,rep,name=standings,proto3" json:"standings,omitempty"` // Ranked by score, then Buchholz, then Sonneborn-Berger
+}
+
+func (x *Standings) Reset() {
+	*x = Standings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standings) ProtoMessage() {}
+
+func (x *Standings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standings.ProtoReflect.Descriptor instead.
+func (*Standings) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Standings) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+func (x *Standings) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type MyActiveGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MyActiveGamesRequest) Reset() {
+	*x = MyActiveGamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MyActiveGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyActiveGamesRequest) ProtoMessage() {}
+
+func (x *MyActiveGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyActiveGamesRequest.ProtoReflect.Descriptor instead.
+func (*MyActiveGamesRequest) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{64}
+}
+
+type GameList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*GameData `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"` // Live games first, then by move deadline
+}
+
+func (x *GameList) Reset() {
+	*x = GameList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_tictactoe_game_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameList) ProtoMessage() {}
+
+func (x *GameList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_tictactoe_game_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameList.ProtoReflect.Descriptor instead.
+func (*GameList) Descriptor() ([]byte, []int) {
+	return file_api_tictactoe_game_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GameList) GetGames() []*GameData {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+var File_api_tictactoe_game_proto protoreflect.FileDescriptor
+
+var file_api_tictactoe_game_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x2f,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x22, 0x4a, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xeb, 0x01,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x6f, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x64, 0x61, 0x79, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x22, 0x4c, 0x0a, 0x0f, 0x4a,
+	0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x2b, 0x0a, 0x10, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0b, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x22, 0xaf, 0x05, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x58, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f,
+	0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68,
+	0x61, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64,
+	0x61, 0x79, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x63, 0x0a, 0x16, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x0e, 0x43, 0x65, 0x6c,
+	0x6c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x3e, 0x0a, 0x10,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x12, 0x2a, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x11, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x1f, 0x0a, 0x07, 0x42, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x06, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x54, 0x6f, 0x57,
+	0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0x56, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x53,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x50, 0x75, 0x7a, 0x7a,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x63, 0x65, 0x6c, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x22, 0x46, 0x0a, 0x0d,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x75, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x30, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x22, 0x6a, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0xe3, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3b, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x49, 0x64, 0x22, 0x3c, 0x0a, 0x17, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64,
+	0x22, 0x16, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0x37, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x19,
+	0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x51, 0x0a, 0x1a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x06, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x08, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x22, 0x34, 0x0a, 0x0a, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x07,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x11, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22,
+	0x45, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x58, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x56, 0x0a, 0x13, 0x53,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
+	0x65, 0x6e, 0x73, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x5f, 0x73,
+	0x68, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x22, 0xc0, 0x02, 0x0a, 0x0f, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x58, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4f, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x15, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x73, 0x5f, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x77, 0x69, 0x6e, 0x73, 0x58, 0x12, 0x15, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x73, 0x5f, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x73, 0x4f, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x72,
+	0x61, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x28, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72,
+	0x66, 0x65, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x66,
+	0x65, 0x69, 0x74, 0x22, 0xfd, 0x04, 0x0a, 0x0a, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f,
+	0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x53, 0x68,
+	0x6f, 0x77, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a,
+	0x0e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x0b, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x6f, 0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x63, 0x68, 0x68, 0x6f,
+	0x6c, 0x7a, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x75, 0x63, 0x68, 0x68, 0x6f,
+	0x6c, 0x7a, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x6e, 0x6e, 0x65, 0x62, 0x6f, 0x72, 0x6e, 0x5f,
+	0x62, 0x65, 0x72, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x6f,
+	0x6e, 0x6e, 0x65, 0x62, 0x6f, 0x72, 0x6e, 0x42, 0x65, 0x72, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0x6b, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x0a,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x16, 0x0a, 0x14,
+	0x4d, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2a, 0x43, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x46, 0x4f, 0x52, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x7e, 0x0a, 0x09, 0x47,
+	0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x41, 0x4d, 0x45,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4c,
+	0x41, 0x59, 0x45, 0x52, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4d, 0x41, 0x44, 0x45, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09,
+	0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x06, 0x2a, 0x1f, 0x0a, 0x0b, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x49, 0x46, 0x10, 0x01, 0x2a, 0x9d, 0x02, 0x0a,
+	0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41,
+	0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x44,
+	0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x52, 0x49,
+	0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x05, 0x12, 0x17,
+	0x0a, 0x13, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x5f, 0x57, 0x41,
+	0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x59, 0x4f, 0x55, 0x52, 0x5f,
+	0x54, 0x55, 0x52, 0x4e, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52,
+	0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x09, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x0a, 0x2a, 0x30, 0x0a, 0x08,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c,
+	0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x2a, 0x2a,
+	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x53, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x50,
+	0x45, 0x43, 0x54, 0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x01, 0x2a, 0x57, 0x0a, 0x0c, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48,
+	0x55, 0x4d, 0x42, 0x53, 0x5f, 0x55, 0x50, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x52,
+	0x50, 0x52, 0x49, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x41, 0x55, 0x47,
+	0x48, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x4f, 0x4f, 0x44, 0x5f, 0x4d,
+	0x4f, 0x56, 0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x55, 0x52, 0x52, 0x59, 0x5f, 0x55,
+	0x50, 0x10, 0x04, 0x2a, 0x3c, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x49, 0x53,
+	0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x4e, 0x4f, 0x43, 0x4b, 0x4f, 0x55, 0x54, 0x10,
+	0x02, 0x2a, 0x40, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x26, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x44, 0x52, 0x41, 0x57, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x57, 0x49, 0x4e, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x02, 0x32, 0x9f, 0x13, 0x0a, 0x0b,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x00, 0x12, 0x2f, 0x0a, 0x08, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x11, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x00, 0x12, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0f, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x74, 0x73,
+	0x12, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x42,
+	0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x53, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x39,
+	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x16, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a,
+	0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x0f, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x56, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1f,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x16, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d,
+	0x0a, 0x0d, 0x4d, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x42, 0x24, 0x5a,
+	0x22, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x69, 0x63, 0x74,
+	0x61, 0x63, 0x74, 0x6f, 0x65, 0x76, 0x31, 0x3b, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f,
+	0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_tictactoe_game_proto_rawDescOnce sync.Once
+	file_api_tictactoe_game_proto_rawDescData = file_api_tictactoe_game_proto_rawDesc
+)
+
+func file_api_tictactoe_game_proto_rawDescGZIP() []byte {
+	file_api_tictactoe_game_proto_rawDescOnce.Do(func() {
+		file_api_tictactoe_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_tictactoe_game_proto_rawDescData)
+	})
+	return file_api_tictactoe_game_proto_rawDescData
+}
+
+var file_api_tictactoe_game_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_api_tictactoe_game_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_api_tictactoe_game_proto_goTypes = []any{
+	(GameStatus)(0),                      // 0: game.GameStatus
+	(GameEvent)(0),                       // 1: game.GameEvent
+	(ImageFormat)(0),                     // 2: game.ImageFormat
+	(NotificationType)(0),                // 3: game.NotificationType
+	(Presence)(0),                        // 4: game.Presence
+	(ChatChannel)(0),                     // 5: game.ChatChannel
+	(ReactionType)(0),                    // 6: game.ReactionType
+	(TournamentFormat)(0),                // 7: game.TournamentFormat
+	(TournamentStatus)(0),                // 8: game.TournamentStatus
+	(Outcome)(0),                         // 9: game.Outcome
+	(*PlayerData)(nil),                   // 10: game.PlayerData
+	(*LoginRequest)(nil),                 // 11: game.LoginRequest
+	(*CreateGameRequest)(nil),            // 12: game.CreateGameRequest
+	(*JoinGameRequest)(nil),              // 13: game.JoinGameRequest
+	(*LeaveGameRequest)(nil),             // 14: game.LeaveGameRequest
+	(*MoveRequest)(nil),                  // 15: game.MoveRequest
+	(*GameRequest)(nil),                  // 16: game.GameRequest
+	(*GameData)(nil),                     // 17: game.GameData
+	(*AnalyzePositionRequest)(nil),       // 18: game.AnalyzePositionRequest
+	(*CellEvaluation)(nil),               // 19: game.CellEvaluation
+	(*PositionAnalysis)(nil),             // 20: game.PositionAnalysis
+	(*ListBotsRequest)(nil),              // 21: game.ListBotsRequest
+	(*BotList)(nil),                      // 22: game.BotList
+	(*GetPuzzleRequest)(nil),             // 23: game.GetPuzzleRequest
+	(*Puzzle)(nil),                       // 24: game.Puzzle
+	(*SubmitPuzzleSolutionRequest)(nil),  // 25: game.SubmitPuzzleSolutionRequest
+	(*PuzzleResult)(nil),                 // 26: game.PuzzleResult
+	(*ExportGameRequest)(nil),            // 27: game.ExportGameRequest
+	(*GameRecord)(nil),                   // 28: game.GameRecord
+	(*ImportGameRequest)(nil),            // 29: game.ImportGameRequest
+	(*RenderGameRequest)(nil),            // 30: game.RenderGameRequest
+	(*RenderedImage)(nil),                // 31: game.RenderedImage
+	(*GetInviteRequest)(nil),             // 32: game.GetInviteRequest
+	(*Invite)(nil),                       // 33: game.Invite
+	(*RevokeJoinCodeRequest)(nil),        // 34: game.RevokeJoinCodeRequest
+	(*RevokeJoinCodeResponse)(nil),       // 35: game.RevokeJoinCodeResponse
+	(*JoinByCodeRequest)(nil),            // 36: game.JoinByCodeRequest
+	(*ChallengePlayerRequest)(nil),       // 37: game.ChallengePlayerRequest
+	(*Challenge)(nil),                    // 38: game.Challenge
+	(*AcceptChallengeRequest)(nil),       // 39: game.AcceptChallengeRequest
+	(*DeclineChallengeRequest)(nil),      // 40: game.DeclineChallengeRequest
+	(*NotificationsRequest)(nil),         // 41: game.NotificationsRequest
+	(*Notification)(nil),                 // 42: game.Notification
+	(*SendFriendRequestRequest)(nil),     // 43: game.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),    // 44: game.SendFriendRequestResponse
+	(*AnswerFriendRequestRequest)(nil),   // 45: game.AnswerFriendRequestRequest
+	(*AnswerFriendRequestResponse)(nil),  // 46: game.AnswerFriendRequestResponse
+	(*ListFriendRequestsRequest)(nil),    // 47: game.ListFriendRequestsRequest
+	(*FriendRequestList)(nil),            // 48: game.FriendRequestList
+	(*RemoveFriendRequest)(nil),          // 49: game.RemoveFriendRequest
+	(*RemoveFriendResponse)(nil),         // 50: game.RemoveFriendResponse
+	(*ListFriendsRequest)(nil),           // 51: game.ListFriendsRequest
+	(*Friend)(nil),                       // 52: game.Friend
+	(*FriendList)(nil),                   // 53: game.FriendList
+	(*HeartbeatRequest)(nil),             // 54: game.HeartbeatRequest
+	(*HeartbeatResponse)(nil),            // 55: game.HeartbeatResponse
+	(*SubscribePresenceRequest)(nil),     // 56: game.SubscribePresenceRequest
+	(*ChatMessage)(nil),                  // 57: game.ChatMessage
+	(*SendChatMessageRequest)(nil),       // 58: game.SendChatMessageRequest
+	(*GetChatRequest)(nil),               // 59: game.GetChatRequest
+	(*Reaction)(nil),                     // 60: game.Reaction
+	(*SendReactionRequest)(nil),          // 61: game.SendReactionRequest
+	(*SendReactionResponse)(nil),         // 62: game.SendReactionResponse
+	(*CreateTournamentRequest)(nil),      // 63: game.CreateTournamentRequest
+	(*TournamentMatch)(nil),              // 64: game.TournamentMatch
+	(*Tournament)(nil),                   // 65: game.Tournament
+	(*ListTournamentsRequest)(nil),       // 66: game.ListTournamentsRequest
+	(*TournamentList)(nil),               // 67: game.TournamentList
+	(*RegisterForTournamentRequest)(nil), // 68: game.RegisterForTournamentRequest
+	(*StartTournamentRequest)(nil),       // 69: game.StartTournamentRequest
+	(*GetStandingsRequest)(nil),          // 70: game.GetStandingsRequest
+	(*SubscribeStandingsRequest)(nil),    // 71: game.SubscribeStandingsRequest
+	(*Standing)(nil),                     // 72: game.Standing
+	(*Standings)(nil),                    // 73: game.Standings
+	(*MyActiveGamesRequest)(nil),         // 74: game.MyActiveGamesRequest
+	(*GameList)(nil),                     // 75: game.GameList
+}
+var file_api_tictactoe_game_proto_depIdxs = []int32{
+	10, // 0: game.GameData.current_player:type_name -> game.PlayerData
+	10, // 1: game.GameData.player_x:type_name -> game.PlayerData
+	10, // 2: game.GameData.player_o:type_name -> game.PlayerData
+	0,  // 3: game.GameData.status:type_name -> game.GameStatus
+	1,  // 4: game.GameData.event:type_name -> game.GameEvent
+	60, // 5: game.GameData.reaction:type_name -> game.Reaction
+	9,  // 6: game.CellEvaluation.outcome:type_name -> game.Outcome
+	19, // 7: game.PositionAnalysis.cells:type_name -> game.CellEvaluation
+	2,  // 8: game.RenderGameRequest.format:type_name -> game.ImageFormat
+	12, // 9: game.ChallengePlayerRequest.settings:type_name -> game.CreateGameRequest
+	10, // 10: game.Challenge.from:type_name -> game.PlayerData
+	10, // 11: game.Challenge.to:type_name -> game.PlayerData
+	12, // 12: game.Challenge.settings:type_name -> game.CreateGameRequest
+	3,  // 13: game.Notification.type:type_name -> game.NotificationType
+	38, // 14: game.Notification.challenge:type_name -> game.Challenge
+	17, // 15: game.Notification.game:type_name -> game.GameData
+	10, // 16: game.Notification.player:type_name -> game.PlayerData
+	10, // 17: game.FriendRequestList.players:type_name -> game.PlayerData
+	10, // 18: game.Friend.player:type_name -> game.PlayerData
+	4,  // 19: game.Friend.presence:type_name -> game.Presence
+	52, // 20: game.FriendList.friends:type_name -> game.Friend
+	10, // 21: game.ChatMessage.from:type_name -> game.PlayerData
+	5,  // 22: game.ChatMessage.channel:type_name -> game.ChatChannel
+	6,  // 23: game.Reaction.type:type_name -> game.ReactionType
+	10, // 24: game.Reaction.from:type_name -> game.PlayerData
+	6,  // 25: game.SendReactionRequest.type:type_name -> game.ReactionType
+	7,  // 26: game.CreateTournamentRequest.format:type_name -> game.TournamentFormat
+	12, // 27: game.CreateTournamentRequest.settings:type_name -> game.CreateGameRequest
+	10, // 28: game.TournamentMatch.player_x:type_name -> game.PlayerData
+	10, // 29: game.TournamentMatch.player_o:type_name -> game.PlayerData
+	10, // 30: game.TournamentMatch.winner:type_name -> game.PlayerData
+	7,  // 31: game.Tournament.format:type_name -> game.TournamentFormat
+	8,  // 32: game.Tournament.status:type_name -> game.TournamentStatus
+	10, // 33: game.Tournament.creator:type_name -> game.PlayerData
+	12, // 34: game.Tournament.settings:type_name -> game.CreateGameRequest
+	10, // 35: game.Tournament.players:type_name -> game.PlayerData
+	64, // 36: game.Tournament.matches:type_name -> game.TournamentMatch
+	65, // 37: game.TournamentList.tournaments:type_name -> game.Tournament
+	10, // 38: game.Standing.player:type_name -> game.PlayerData
+	65, // 39: game.Standings.tournament:type_name -> game.Tournament
+	72, // 40: game.Standings.standings:type_name -> game.Standing
+	17, // 41: game.GameList.games:type_name -> game.GameData
+	11, // 42: game.GameService.Login:input_type -> game.LoginRequest
+	12, // 43: game.GameService.CreateGame:input_type -> game.CreateGameRequest
+	13, // 44: game.GameService.JoinGame:input_type -> game.JoinGameRequest
+	14, // 45: game.GameService.LeaveGame:input_type -> game.LeaveGameRequest
+	15, // 46: game.GameService.MakeMove:input_type -> game.MoveRequest
+	16, // 47: game.GameService.GetGameState:input_type -> game.GameRequest
+	18, // 48: game.GameService.AnalyzePosition:input_type -> game.AnalyzePositionRequest
+	21, // 49: game.GameService.ListBots:input_type -> game.ListBotsRequest
+	23, // 50: game.GameService.GetPuzzle:input_type -> game.GetPuzzleRequest
+	25, // 51: game.GameService.SubmitPuzzleSolution:input_type -> game.SubmitPuzzleSolutionRequest
+	27, // 52: game.GameService.ExportGame:input_type -> game.ExportGameRequest
+	29, // 53: game.GameService.ImportGame:input_type -> game.ImportGameRequest
+	30, // 54: game.GameService.RenderGame:input_type -> game.RenderGameRequest
+	32, // 55: game.GameService.GetInvite:input_type -> game.GetInviteRequest
+	34, // 56: game.GameService.RevokeJoinCode:input_type -> game.RevokeJoinCodeRequest
+	36, // 57: game.GameService.JoinByCode:input_type -> game.JoinByCodeRequest
+	37, // 58: game.GameService.ChallengePlayer:input_type -> game.ChallengePlayerRequest
+	39, // 59: game.GameService.AcceptChallenge:input_type -> game.AcceptChallengeRequest
+	40, // 60: game.GameService.DeclineChallenge:input_type -> game.DeclineChallengeRequest
+	41, // 61: game.GameService.GetNotifications:input_type -> game.NotificationsRequest
+	43, // 62: game.GameService.SendFriendRequest:input_type -> game.SendFriendRequestRequest
+	45, // 63: game.GameService.AnswerFriendRequest:input_type -> game.AnswerFriendRequestRequest
+	47, // 64: game.GameService.ListFriendRequests:input_type -> game.ListFriendRequestsRequest
+	49, // 65: game.GameService.RemoveFriend:input_type -> game.RemoveFriendRequest
+	51, // 66: game.GameService.ListFriends:input_type -> game.ListFriendsRequest
+	54, // 67: game.GameService.Heartbeat:input_type -> game.HeartbeatRequest
+	56, // 68: game.GameService.SubscribePresence:input_type -> game.SubscribePresenceRequest
+	58, // 69: game.GameService.SendChatMessage:input_type -> game.SendChatMessageRequest
+	59, // 70: game.GameService.GetChat:input_type -> game.GetChatRequest
+	61, // 71: game.GameService.SendReaction:input_type -> game.SendReactionRequest
+	63, // 72: game.GameService.CreateTournament:input_type -> game.CreateTournamentRequest
+	66, // 73: game.GameService.ListTournaments:input_type -> game.ListTournamentsRequest
+	68, // 74: game.GameService.RegisterForTournament:input_type -> game.RegisterForTournamentRequest
+	69, // 75: game.GameService.StartTournament:input_type -> game.StartTournamentRequest
+	70, // 76: game.GameService.GetStandings:input_type -> game.GetStandingsRequest
+	71, // 77: game.GameService.SubscribeStandings:input_type -> game.SubscribeStandingsRequest
+	74, // 78: game.GameService.MyActiveGames:input_type -> game.MyActiveGamesRequest
+	10, // 79: game.GameService.Login:output_type -> game.PlayerData
+	17, // 80: game.GameService.CreateGame:output_type -> game.GameData
+	17, // 81: game.GameService.JoinGame:output_type -> game.GameData
+	17, // 82: game.GameService.LeaveGame:output_type -> game.GameData
+	17, // 83: game.GameService.MakeMove:output_type -> game.GameData
+	17, // 84: game.GameService.GetGameState:output_type -> game.GameData
+	20, // 85: game.GameService.AnalyzePosition:output_type -> game.PositionAnalysis
+	22, // 86: game.GameService.ListBots:output_type -> game.BotList
+	24, // 87: game.GameService.GetPuzzle:output_type -> game.Puzzle
+	26, // 88: game.GameService.SubmitPuzzleSolution:output_type -> game.PuzzleResult
+	28, // 89: game.GameService.ExportGame:output_type -> game.GameRecord
+	17, // 90: game.GameService.ImportGame:output_type -> game.GameData
+	31, // 91: game.GameService.RenderGame:output_type -> game.RenderedImage
+	33, // 92: game.GameService.GetInvite:output_type -> game.Invite
+	35, // 93: game.GameService.RevokeJoinCode:output_type -> game.RevokeJoinCodeResponse
+	17, // 94: game.GameService.JoinByCode:output_type -> game.GameData
+	38, // 95: game.GameService.ChallengePlayer:output_type -> game.Challenge
+	17, // 96: game.GameService.AcceptChallenge:output_type -> game.GameData
+	38, // 97: game.GameService.DeclineChallenge:output_type -> game.Challenge
+	42, // 98: game.GameService.GetNotifications:output_type -> game.Notification
+	44, // 99: game.GameService.SendFriendRequest:output_type -> game.SendFriendRequestResponse
+	46, // 100: game.GameService.AnswerFriendRequest:output_type -> game.AnswerFriendRequestResponse
+	48, // 101: game.GameService.ListFriendRequests:output_type -> game.FriendRequestList
+	50, // 102: game.GameService.RemoveFriend:output_type -> game.RemoveFriendResponse
+	53, // 103: game.GameService.ListFriends:output_type -> game.FriendList
+	55, // 104: game.GameService.Heartbeat:output_type -> game.HeartbeatResponse
+	52, // 105: game.GameService.SubscribePresence:output_type -> game.Friend
+	57, // 106: game.GameService.SendChatMessage:output_type -> game.ChatMessage
+	57, // 107: game.GameService.GetChat:output_type -> game.ChatMessage
+	62, // 108: game.GameService.SendReaction:output_type -> game.SendReactionResponse
+	65, // 109: game.GameService.CreateTournament:output_type -> game.Tournament
+	67, // 110: game.GameService.ListTournaments:output_type -> game.TournamentList
+	65, // 111: game.GameService.RegisterForTournament:output_type -> game.Tournament
+	65, // 112: game.GameService.StartTournament:output_type -> game.Tournament
+	73, // 113: game.GameService.GetStandings:output_type -> game.Standings
+	73, // 114: game.GameService.SubscribeStandings:output_type -> game.Standings
+	75, // 115: game.GameService.MyActiveGames:output_type -> game.GameList
+	79, // [79:116] is the sub-list for method output_type
+	42, // [42:79] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_api_tictactoe_game_proto_init() }
+func file_api_tictactoe_game_proto_init() {
+	if File_api_tictactoe_game_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_tictactoe_game_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*PlayerData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaveGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GameData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzePositionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CellEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*PositionAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BotList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPuzzleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*Puzzle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitPuzzleSolutionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*PuzzleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GameRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*RenderGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RenderedImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*Invite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeJoinCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeJoinCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinByCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ChallengePlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*Challenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*AcceptChallengeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*DeclineChallengeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*NotificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*SendFriendRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*SendFriendRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -744,8 +5698,8 @@ func file_api_tictactoe_game_proto_init() {
 				return nil
 			}
 		}
-		file_api_tictactoe_game_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*LoginRequest); i {
+		file_api_tictactoe_game_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*AnswerFriendRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -756,8 +5710,8 @@ func file_api_tictactoe_game_proto_init() {
 				return nil
 			}
 		}
-		file_api_tictactoe_game_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateGameRequest); i {
+		file_api_tictactoe_game_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*AnswerFriendRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -768,8 +5722,8 @@ func file_api_tictactoe_game_proto_init() {
 				return nil
 			}
 		}
-		file_api_tictactoe_game_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*JoinGameRequest); i {
+		file_api_tictactoe_game_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -780,8 +5734,8 @@ func file_api_tictactoe_game_proto_init() {
 				return nil
 			}
 		}
-		file_api_tictactoe_game_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*LeaveGameRequest); i {
+		file_api_tictactoe_game_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*FriendRequestList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -792,8 +5746,8 @@ func file_api_tictactoe_game_proto_init() {
 				return nil
 			}
 		}
-		file_api_tictactoe_game_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*MoveRequest); i {
+		file_api_tictactoe_game_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveFriendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -804,8 +5758,8 @@ func file_api_tictactoe_game_proto_init() {
 				return nil
 			}
 		}
-		file_api_tictactoe_game_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GameRequest); i {
+		file_api_tictactoe_game_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveFriendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -816,8 +5770,296 @@ func file_api_tictactoe_game_proto_init() {
 				return nil
 			}
 		}
-		file_api_tictactoe_game_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GameData); i {
+		file_api_tictactoe_game_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFriendsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*Friend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*FriendList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*HeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*HeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribePresenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SendChatMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*Reaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*SendReactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*SendReactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*TournamentMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*Tournament); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTournamentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*TournamentList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterForTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*StartTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStandingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeStandingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*Standings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*MyActiveGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_tictactoe_game_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GameList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -834,8 +6076,8 @@ func file_api_tictactoe_game_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_tictactoe_game_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      10,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GameService_Login_FullMethodName        = "/game.GameService/Login"
-	GameService_CreateGame_FullMethodName   = "/game.GameService/CreateGame"
-	GameService_JoinGame_FullMethodName     = "/game.GameService/JoinGame"
-	GameService_LeaveGame_FullMethodName    = "/game.GameService/LeaveGame"
-	GameService_MakeMove_FullMethodName     = "/game.GameService/MakeMove"
-	GameService_GetGameState_FullMethodName = "/game.GameService/GetGameState"
+	GameService_Login_FullMethodName                 = "/game.GameService/Login"
+	GameService_CreateGame_FullMethodName            = "/game.GameService/CreateGame"
+	GameService_JoinGame_FullMethodName              = "/game.GameService/JoinGame"
+	GameService_LeaveGame_FullMethodName             = "/game.GameService/LeaveGame"
+	GameService_MakeMove_FullMethodName              = "/game.GameService/MakeMove"
+	GameService_GetGameState_FullMethodName          = "/game.GameService/GetGameState"
+	GameService_AnalyzePosition_FullMethodName       = "/game.GameService/AnalyzePosition"
+	GameService_ListBots_FullMethodName              = "/game.GameService/ListBots"
+	GameService_GetPuzzle_FullMethodName             = "/game.GameService/GetPuzzle"
+	GameService_SubmitPuzzleSolution_FullMethodName  = "/game.GameService/SubmitPuzzleSolution"
+	GameService_ExportGame_FullMethodName            = "/game.GameService/ExportGame"
+	GameService_ImportGame_FullMethodName            = "/game.GameService/ImportGame"
+	GameService_RenderGame_FullMethodName            = "/game.GameService/RenderGame"
+	GameService_GetInvite_FullMethodName             = "/game.GameService/GetInvite"
+	GameService_RevokeJoinCode_FullMethodName        = "/game.GameService/RevokeJoinCode"
+	GameService_JoinByCode_FullMethodName            = "/game.GameService/JoinByCode"
+	GameService_ChallengePlayer_FullMethodName       = "/game.GameService/ChallengePlayer"
+	GameService_AcceptChallenge_FullMethodName       = "/game.GameService/AcceptChallenge"
+	GameService_DeclineChallenge_FullMethodName      = "/game.GameService/DeclineChallenge"
+	GameService_GetNotifications_FullMethodName      = "/game.GameService/GetNotifications"
+	GameService_SendFriendRequest_FullMethodName     = "/game.GameService/SendFriendRequest"
+	GameService_AnswerFriendRequest_FullMethodName   = "/game.GameService/AnswerFriendRequest"
+	GameService_ListFriendRequests_FullMethodName    = "/game.GameService/ListFriendRequests"
+	GameService_RemoveFriend_FullMethodName          = "/game.GameService/RemoveFriend"
+	GameService_ListFriends_FullMethodName           = "/game.GameService/ListFriends"
+	GameService_Heartbeat_FullMethodName             = "/game.GameService/Heartbeat"
+	GameService_SubscribePresence_FullMethodName     = "/game.GameService/SubscribePresence"
+	GameService_SendChatMessage_FullMethodName       = "/game.GameService/SendChatMessage"
+	GameService_GetChat_FullMethodName               = "/game.GameService/GetChat"
+	GameService_SendReaction_FullMethodName          = "/game.GameService/SendReaction"
+	GameService_CreateTournament_FullMethodName      = "/game.GameService/CreateTournament"
+	GameService_ListTournaments_FullMethodName       = "/game.GameService/ListTournaments"
+	GameService_RegisterForTournament_FullMethodName = "/game.GameService/RegisterForTournament"
+	GameService_StartTournament_FullMethodName       = "/game.GameService/StartTournament"
+	GameService_GetStandings_FullMethodName          = "/game.GameService/GetStandings"
+	GameService_SubscribeStandings_FullMethodName    = "/game.GameService/SubscribeStandings"
+	GameService_MyActiveGames_FullMethodName         = "/game.GameService/MyActiveGames"
 )
 
 // GameServiceClient is the client API for GameService service.
@@ -37,6 +68,37 @@ type GameServiceClient interface {
 	LeaveGame(ctx context.Context, in *LeaveGameRequest, opts ...grpc.CallOption) (*GameData, error)
 	MakeMove(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*GameData, error)
 	GetGameState(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameData], error)
+	AnalyzePosition(ctx context.Context, in *AnalyzePositionRequest, opts ...grpc.CallOption) (*PositionAnalysis, error)
+	ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*BotList, error)
+	GetPuzzle(ctx context.Context, in *GetPuzzleRequest, opts ...grpc.CallOption) (*Puzzle, error)
+	SubmitPuzzleSolution(ctx context.Context, in *SubmitPuzzleSolutionRequest, opts ...grpc.CallOption) (*PuzzleResult, error)
+	ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*GameRecord, error)
+	ImportGame(ctx context.Context, in *ImportGameRequest, opts ...grpc.CallOption) (*GameData, error)
+	RenderGame(ctx context.Context, in *RenderGameRequest, opts ...grpc.CallOption) (*RenderedImage, error)
+	GetInvite(ctx context.Context, in *GetInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+	RevokeJoinCode(ctx context.Context, in *RevokeJoinCodeRequest, opts ...grpc.CallOption) (*RevokeJoinCodeResponse, error)
+	JoinByCode(ctx context.Context, in *JoinByCodeRequest, opts ...grpc.CallOption) (*GameData, error)
+	ChallengePlayer(ctx context.Context, in *ChallengePlayerRequest, opts ...grpc.CallOption) (*Challenge, error)
+	AcceptChallenge(ctx context.Context, in *AcceptChallengeRequest, opts ...grpc.CallOption) (*GameData, error)
+	DeclineChallenge(ctx context.Context, in *DeclineChallengeRequest, opts ...grpc.CallOption) (*Challenge, error)
+	GetNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error)
+	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error)
+	AnswerFriendRequest(ctx context.Context, in *AnswerFriendRequestRequest, opts ...grpc.CallOption) (*AnswerFriendRequestResponse, error)
+	ListFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*FriendRequestList, error)
+	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error)
+	ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*FriendList, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Friend], error)
+	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*ChatMessage, error)
+	GetChat(ctx context.Context, in *GetChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatMessage], error)
+	SendReaction(ctx context.Context, in *SendReactionRequest, opts ...grpc.CallOption) (*SendReactionResponse, error)
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	ListTournaments(ctx context.Context, in *ListTournamentsRequest, opts ...grpc.CallOption) (*TournamentList, error)
+	RegisterForTournament(ctx context.Context, in *RegisterForTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	StartTournament(ctx context.Context, in *StartTournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
+	GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*Standings, error)
+	SubscribeStandings(ctx context.Context, in *SubscribeStandingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Standings], error)
+	MyActiveGames(ctx context.Context, in *MyActiveGamesRequest, opts ...grpc.CallOption) (*GameList, error)
 }
 
 type gameServiceClient struct {
@@ -116,6 +178,352 @@ func (c *gameServiceClient) GetGameState(ctx context.Context, in *GameRequest, o
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type GameService_GetGameStateClient = grpc.ServerStreamingClient[GameData]
 
+func (c *gameServiceClient) AnalyzePosition(ctx context.Context, in *AnalyzePositionRequest, opts ...grpc.CallOption) (*PositionAnalysis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PositionAnalysis)
+	err := c.cc.Invoke(ctx, GameService_AnalyzePosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*BotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BotList)
+	err := c.cc.Invoke(ctx, GameService_ListBots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetPuzzle(ctx context.Context, in *GetPuzzleRequest, opts ...grpc.CallOption) (*Puzzle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Puzzle)
+	err := c.cc.Invoke(ctx, GameService_GetPuzzle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SubmitPuzzleSolution(ctx context.Context, in *SubmitPuzzleSolutionRequest, opts ...grpc.CallOption) (*PuzzleResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PuzzleResult)
+	err := c.cc.Invoke(ctx, GameService_SubmitPuzzleSolution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*GameRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRecord)
+	err := c.cc.Invoke(ctx, GameService_ExportGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ImportGame(ctx context.Context, in *ImportGameRequest, opts ...grpc.CallOption) (*GameData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameData)
+	err := c.cc.Invoke(ctx, GameService_ImportGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RenderGame(ctx context.Context, in *RenderGameRequest, opts ...grpc.CallOption) (*RenderedImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderedImage)
+	err := c.cc.Invoke(ctx, GameService_RenderGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetInvite(ctx context.Context, in *GetInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, GameService_GetInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RevokeJoinCode(ctx context.Context, in *RevokeJoinCodeRequest, opts ...grpc.CallOption) (*RevokeJoinCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeJoinCodeResponse)
+	err := c.cc.Invoke(ctx, GameService_RevokeJoinCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) JoinByCode(ctx context.Context, in *JoinByCodeRequest, opts ...grpc.CallOption) (*GameData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameData)
+	err := c.cc.Invoke(ctx, GameService_JoinByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ChallengePlayer(ctx context.Context, in *ChallengePlayerRequest, opts ...grpc.CallOption) (*Challenge, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Challenge)
+	err := c.cc.Invoke(ctx, GameService_ChallengePlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AcceptChallenge(ctx context.Context, in *AcceptChallengeRequest, opts ...grpc.CallOption) (*GameData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameData)
+	err := c.cc.Invoke(ctx, GameService_AcceptChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) DeclineChallenge(ctx context.Context, in *DeclineChallengeRequest, opts ...grpc.CallOption) (*Challenge, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Challenge)
+	err := c.cc.Invoke(ctx, GameService_DeclineChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[1], GameService_GetNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[NotificationsRequest, Notification]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_GetNotificationsClient = grpc.ServerStreamingClient[Notification]
+
+func (c *gameServiceClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendFriendRequestResponse)
+	err := c.cc.Invoke(ctx, GameService_SendFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AnswerFriendRequest(ctx context.Context, in *AnswerFriendRequestRequest, opts ...grpc.CallOption) (*AnswerFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswerFriendRequestResponse)
+	err := c.cc.Invoke(ctx, GameService_AnswerFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*FriendRequestList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendRequestList)
+	err := c.cc.Invoke(ctx, GameService_ListFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFriendResponse)
+	err := c.cc.Invoke(ctx, GameService_RemoveFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*FriendList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendList)
+	err := c.cc.Invoke(ctx, GameService_ListFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, GameService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Friend], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[2], GameService_SubscribePresence_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribePresenceRequest, Friend]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_SubscribePresenceClient = grpc.ServerStreamingClient[Friend]
+
+func (c *gameServiceClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*ChatMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatMessage)
+	err := c.cc.Invoke(ctx, GameService_SendChatMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetChat(ctx context.Context, in *GetChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[3], GameService_GetChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetChatRequest, ChatMessage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_GetChatClient = grpc.ServerStreamingClient[ChatMessage]
+
+func (c *gameServiceClient) SendReaction(ctx context.Context, in *SendReactionRequest, opts ...grpc.CallOption) (*SendReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendReactionResponse)
+	err := c.cc.Invoke(ctx, GameService_SendReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, GameService_CreateTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListTournaments(ctx context.Context, in *ListTournamentsRequest, opts ...grpc.CallOption) (*TournamentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TournamentList)
+	err := c.cc.Invoke(ctx, GameService_ListTournaments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RegisterForTournament(ctx context.Context, in *RegisterForTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, GameService_RegisterForTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) StartTournament(ctx context.Context, in *StartTournamentRequest, opts ...grpc.CallOption) (*Tournament, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tournament)
+	err := c.cc.Invoke(ctx, GameService_StartTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*Standings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Standings)
+	err := c.cc.Invoke(ctx, GameService_GetStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SubscribeStandings(ctx context.Context, in *SubscribeStandingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Standings], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[4], GameService_SubscribeStandings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeStandingsRequest, Standings]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_SubscribeStandingsClient = grpc.ServerStreamingClient[Standings]
+
+func (c *gameServiceClient) MyActiveGames(ctx context.Context, in *MyActiveGamesRequest, opts ...grpc.CallOption) (*GameList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameList)
+	err := c.cc.Invoke(ctx, GameService_MyActiveGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameServiceServer is the server API for GameService service.
 // All implementations must embed UnimplementedGameServiceServer
 // for forward compatibility.
@@ -126,155 +534,809 @@ type GameServiceServer interface {
 	LeaveGame(context.Context, *LeaveGameRequest) (*GameData, error)
 	MakeMove(context.Context, *MoveRequest) (*GameData, error)
 	GetGameState(*GameRequest, grpc.ServerStreamingServer[GameData]) error
+	AnalyzePosition(context.Context, *AnalyzePositionRequest) (*PositionAnalysis, error)
+	ListBots(context.Context, *ListBotsRequest) (*BotList, error)
+	GetPuzzle(context.Context, *GetPuzzleRequest) (*Puzzle, error)
+	SubmitPuzzleSolution(context.Context, *SubmitPuzzleSolutionRequest) (*PuzzleResult, error)
+	ExportGame(context.Context, *ExportGameRequest) (*GameRecord, error)
+	ImportGame(context.Context, *ImportGameRequest) (*GameData, error)
+	RenderGame(context.Context, *RenderGameRequest) (*RenderedImage, error)
+	GetInvite(context.Context, *GetInviteRequest) (*Invite, error)
+	RevokeJoinCode(context.Context, *RevokeJoinCodeRequest) (*RevokeJoinCodeResponse, error)
+	JoinByCode(context.Context, *JoinByCodeRequest) (*GameData, error)
+	ChallengePlayer(context.Context, *ChallengePlayerRequest) (*Challenge, error)
+	AcceptChallenge(context.Context, *AcceptChallengeRequest) (*GameData, error)
+	DeclineChallenge(context.Context, *DeclineChallengeRequest) (*Challenge, error)
+	GetNotifications(*NotificationsRequest, grpc.ServerStreamingServer[Notification]) error
+	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error)
+	AnswerFriendRequest(context.Context, *AnswerFriendRequestRequest) (*AnswerFriendRequestResponse, error)
+	ListFriendRequests(context.Context, *ListFriendRequestsRequest) (*FriendRequestList, error)
+	RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error)
+	ListFriends(context.Context, *ListFriendsRequest) (*FriendList, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	SubscribePresence(*SubscribePresenceRequest, grpc.ServerStreamingServer[Friend]) error
+	SendChatMessage(context.Context, *SendChatMessageRequest) (*ChatMessage, error)
+	GetChat(*GetChatRequest, grpc.ServerStreamingServer[ChatMessage]) error
+	SendReaction(context.Context, *SendReactionRequest) (*SendReactionResponse, error)
+	CreateTournament(context.Context, *CreateTournamentRequest) (*Tournament, error)
+	ListTournaments(context.Context, *ListTournamentsRequest) (*TournamentList, error)
+	RegisterForTournament(context.Context, *RegisterForTournamentRequest) (*Tournament, error)
+	StartTournament(context.Context, *StartTournamentRequest) (*Tournament, error)
+	GetStandings(context.Context, *GetStandingsRequest) (*Standings, error)
+	SubscribeStandings(*SubscribeStandingsRequest, grpc.ServerStreamingServer[Standings]) error
+	MyActiveGames(context.Context, *MyActiveGamesRequest) (*GameList, error)
 	mustEmbedUnimplementedGameServiceServer()
 }
 
-// UnimplementedGameServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGameServiceServer struct{}
+// UnimplementedGameServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGameServiceServer struct{}
+
+func (UnimplementedGameServiceServer) Login(context.Context, *LoginRequest) (*PlayerData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedGameServiceServer) CreateGame(context.Context, *CreateGameRequest) (*GameData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (UnimplementedGameServiceServer) JoinGame(context.Context, *JoinGameRequest) (*GameData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+}
+func (UnimplementedGameServiceServer) LeaveGame(context.Context, *LeaveGameRequest) (*GameData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGame not implemented")
+}
+func (UnimplementedGameServiceServer) MakeMove(context.Context, *MoveRequest) (*GameData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeMove not implemented")
+}
+func (UnimplementedGameServiceServer) GetGameState(*GameRequest, grpc.ServerStreamingServer[GameData]) error {
+	return status.Errorf(codes.Unimplemented, "method GetGameState not implemented")
+}
+func (UnimplementedGameServiceServer) AnalyzePosition(context.Context, *AnalyzePositionRequest) (*PositionAnalysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzePosition not implemented")
+}
+func (UnimplementedGameServiceServer) ListBots(context.Context, *ListBotsRequest) (*BotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBots not implemented")
+}
+func (UnimplementedGameServiceServer) GetPuzzle(context.Context, *GetPuzzleRequest) (*Puzzle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPuzzle not implemented")
+}
+func (UnimplementedGameServiceServer) SubmitPuzzleSolution(context.Context, *SubmitPuzzleSolutionRequest) (*PuzzleResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPuzzleSolution not implemented")
+}
+func (UnimplementedGameServiceServer) ExportGame(context.Context, *ExportGameRequest) (*GameRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGame not implemented")
+}
+func (UnimplementedGameServiceServer) ImportGame(context.Context, *ImportGameRequest) (*GameData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGame not implemented")
+}
+func (UnimplementedGameServiceServer) RenderGame(context.Context, *RenderGameRequest) (*RenderedImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderGame not implemented")
+}
+func (UnimplementedGameServiceServer) GetInvite(context.Context, *GetInviteRequest) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvite not implemented")
+}
+func (UnimplementedGameServiceServer) RevokeJoinCode(context.Context, *RevokeJoinCodeRequest) (*RevokeJoinCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeJoinCode not implemented")
+}
+func (UnimplementedGameServiceServer) JoinByCode(context.Context, *JoinByCodeRequest) (*GameData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinByCode not implemented")
+}
+func (UnimplementedGameServiceServer) ChallengePlayer(context.Context, *ChallengePlayerRequest) (*Challenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengePlayer not implemented")
+}
+func (UnimplementedGameServiceServer) AcceptChallenge(context.Context, *AcceptChallengeRequest) (*GameData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptChallenge not implemented")
+}
+func (UnimplementedGameServiceServer) DeclineChallenge(context.Context, *DeclineChallengeRequest) (*Challenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineChallenge not implemented")
+}
+func (UnimplementedGameServiceServer) GetNotifications(*NotificationsRequest, grpc.ServerStreamingServer[Notification]) error {
+	return status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedGameServiceServer) SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedGameServiceServer) AnswerFriendRequest(context.Context, *AnswerFriendRequestRequest) (*AnswerFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerFriendRequest not implemented")
+}
+func (UnimplementedGameServiceServer) ListFriendRequests(context.Context, *ListFriendRequestsRequest) (*FriendRequestList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriendRequests not implemented")
+}
+func (UnimplementedGameServiceServer) RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedGameServiceServer) ListFriends(context.Context, *ListFriendsRequest) (*FriendList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
+}
+func (UnimplementedGameServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedGameServiceServer) SubscribePresence(*SubscribePresenceRequest, grpc.ServerStreamingServer[Friend]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePresence not implemented")
+}
+func (UnimplementedGameServiceServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*ChatMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessage not implemented")
+}
+func (UnimplementedGameServiceServer) GetChat(*GetChatRequest, grpc.ServerStreamingServer[ChatMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method GetChat not implemented")
+}
+func (UnimplementedGameServiceServer) SendReaction(context.Context, *SendReactionRequest) (*SendReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendReaction not implemented")
+}
+func (UnimplementedGameServiceServer) CreateTournament(context.Context, *CreateTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (UnimplementedGameServiceServer) ListTournaments(context.Context, *ListTournamentsRequest) (*TournamentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTournaments not implemented")
+}
+func (UnimplementedGameServiceServer) RegisterForTournament(context.Context, *RegisterForTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterForTournament not implemented")
+}
+func (UnimplementedGameServiceServer) StartTournament(context.Context, *StartTournamentRequest) (*Tournament, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTournament not implemented")
+}
+func (UnimplementedGameServiceServer) GetStandings(context.Context, *GetStandingsRequest) (*Standings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedGameServiceServer) SubscribeStandings(*SubscribeStandingsRequest, grpc.ServerStreamingServer[Standings]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeStandings not implemented")
+}
+func (UnimplementedGameServiceServer) MyActiveGames(context.Context, *MyActiveGamesRequest) (*GameList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MyActiveGames not implemented")
+}
+func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
+func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeGameServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameServiceServer will
+// result in compilation errors.
+type UnsafeGameServiceServer interface {
+	mustEmbedUnimplementedGameServiceServer()
+}
+
+func RegisterGameServiceServer(s grpc.ServiceRegistrar, srv GameServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGameServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GameService_ServiceDesc, srv)
+}
+
+func _GameService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CreateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).JoinGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_JoinGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).JoinGame(ctx, req.(*JoinGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_LeaveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).LeaveGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_LeaveGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).LeaveGame(ctx, req.(*LeaveGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_MakeMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).MakeMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_MakeMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).MakeMove(ctx, req.(*MoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetGameState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).GetGameState(m, &grpc.GenericServerStream[GameRequest, GameData]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_GetGameStateServer = grpc.ServerStreamingServer[GameData]
+
+func _GameService_AnalyzePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AnalyzePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AnalyzePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AnalyzePosition(ctx, req.(*AnalyzePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListBots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListBots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListBots(ctx, req.(*ListBotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetPuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetPuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetPuzzle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetPuzzle(ctx, req.(*GetPuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SubmitPuzzleSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPuzzleSolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SubmitPuzzleSolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SubmitPuzzleSolution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SubmitPuzzleSolution(ctx, req.(*SubmitPuzzleSolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ExportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ExportGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ExportGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ExportGame(ctx, req.(*ExportGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ImportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ImportGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ImportGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ImportGame(ctx, req.(*ImportGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RenderGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RenderGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RenderGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RenderGame(ctx, req.(*RenderGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetInvite(ctx, req.(*GetInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RevokeJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeJoinCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RevokeJoinCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RevokeJoinCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RevokeJoinCode(ctx, req.(*RevokeJoinCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_JoinByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).JoinByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_JoinByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).JoinByCode(ctx, req.(*JoinByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ChallengePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ChallengePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ChallengePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ChallengePlayer(ctx, req.(*ChallengePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AcceptChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AcceptChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AcceptChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AcceptChallenge(ctx, req.(*AcceptChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_DeclineChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).DeclineChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_DeclineChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).DeclineChallenge(ctx, req.(*DeclineChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).GetNotifications(m, &grpc.GenericServerStream[NotificationsRequest, Notification]{ServerStream: stream})
+}
 
-func (UnimplementedGameServiceServer) Login(context.Context, *LoginRequest) (*PlayerData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_GetNotificationsServer = grpc.ServerStreamingServer[Notification]
+
+func _GameService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SendFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SendFriendRequest(ctx, req.(*SendFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGameServiceServer) CreateGame(context.Context, *CreateGameRequest) (*GameData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+
+func _GameService_AnswerFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AnswerFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AnswerFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AnswerFriendRequest(ctx, req.(*AnswerFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGameServiceServer) JoinGame(context.Context, *JoinGameRequest) (*GameData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+
+func _GameService_ListFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListFriendRequests(ctx, req.(*ListFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGameServiceServer) LeaveGame(context.Context, *LeaveGameRequest) (*GameData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveGame not implemented")
+
+func _GameService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RemoveFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RemoveFriend(ctx, req.(*RemoveFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGameServiceServer) MakeMove(context.Context, *MoveRequest) (*GameData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MakeMove not implemented")
+
+func _GameService_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListFriends(ctx, req.(*ListFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGameServiceServer) GetGameState(*GameRequest, grpc.ServerStreamingServer[GameData]) error {
-	return status.Errorf(codes.Unimplemented, "method GetGameState not implemented")
+
+func _GameService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
-func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeGameServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GameServiceServer will
-// result in compilation errors.
-type UnsafeGameServiceServer interface {
-	mustEmbedUnimplementedGameServiceServer()
+func _GameService_SubscribePresence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePresenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).SubscribePresence(m, &grpc.GenericServerStream[SubscribePresenceRequest, Friend]{ServerStream: stream})
 }
 
-func RegisterGameServiceServer(s grpc.ServiceRegistrar, srv GameServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGameServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_SubscribePresenceServer = grpc.ServerStreamingServer[Friend]
+
+func _GameService_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&GameService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GameServiceServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SendChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SendChatMessage(ctx, req.(*SendChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _GameService_GetChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).GetChat(m, &grpc.GenericServerStream[GetChatRequest, ChatMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_GetChatServer = grpc.ServerStreamingServer[ChatMessage]
+
+func _GameService_SendReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).Login(ctx, in)
+		return srv.(GameServiceServer).SendReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameService_Login_FullMethodName,
+		FullMethod: GameService_SendReaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(GameServiceServer).SendReaction(ctx, req.(*SendReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGameRequest)
+func _GameService_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).CreateGame(ctx, in)
+		return srv.(GameServiceServer).CreateTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameService_CreateGame_FullMethodName,
+		FullMethod: GameService_CreateTournament_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).CreateGame(ctx, req.(*CreateGameRequest))
+		return srv.(GameServiceServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinGameRequest)
+func _GameService_ListTournaments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTournamentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).JoinGame(ctx, in)
+		return srv.(GameServiceServer).ListTournaments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameService_JoinGame_FullMethodName,
+		FullMethod: GameService_ListTournaments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).JoinGame(ctx, req.(*JoinGameRequest))
+		return srv.(GameServiceServer).ListTournaments(ctx, req.(*ListTournamentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_LeaveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveGameRequest)
+func _GameService_RegisterForTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterForTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).LeaveGame(ctx, in)
+		return srv.(GameServiceServer).RegisterForTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameService_LeaveGame_FullMethodName,
+		FullMethod: GameService_RegisterForTournament_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).LeaveGame(ctx, req.(*LeaveGameRequest))
+		return srv.(GameServiceServer).RegisterForTournament(ctx, req.(*RegisterForTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_MakeMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MoveRequest)
+func _GameService_StartTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTournamentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).MakeMove(ctx, in)
+		return srv.(GameServiceServer).StartTournament(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GameService_MakeMove_FullMethodName,
+		FullMethod: GameService_StartTournament_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).MakeMove(ctx, req.(*MoveRequest))
+		return srv.(GameServiceServer).StartTournament(ctx, req.(*StartTournamentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_GetGameState_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GameRequest)
+func _GameService_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetStandings(ctx, req.(*GetStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SubscribeStandings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStandingsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GameServiceServer).GetGameState(m, &grpc.GenericServerStream[GameRequest, GameData]{ServerStream: stream})
+	return srv.(GameServiceServer).SubscribeStandings(m, &grpc.GenericServerStream[SubscribeStandingsRequest, Standings]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type GameService_GetGameStateServer = grpc.ServerStreamingServer[GameData]
+type GameService_SubscribeStandingsServer = grpc.ServerStreamingServer[Standings]
+
+func _GameService_MyActiveGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MyActiveGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).MyActiveGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_MyActiveGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).MyActiveGames(ctx, req.(*MyActiveGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
 // GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
 // It's only intended for direct use with grpc.RegisterService,
@@ -303,6 +1365,114 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MakeMove",
 			Handler:    _GameService_MakeMove_Handler,
 		},
+		{
+			MethodName: "AnalyzePosition",
+			Handler:    _GameService_AnalyzePosition_Handler,
+		},
+		{
+			MethodName: "ListBots",
+			Handler:    _GameService_ListBots_Handler,
+		},
+		{
+			MethodName: "GetPuzzle",
+			Handler:    _GameService_GetPuzzle_Handler,
+		},
+		{
+			MethodName: "SubmitPuzzleSolution",
+			Handler:    _GameService_SubmitPuzzleSolution_Handler,
+		},
+		{
+			MethodName: "ExportGame",
+			Handler:    _GameService_ExportGame_Handler,
+		},
+		{
+			MethodName: "ImportGame",
+			Handler:    _GameService_ImportGame_Handler,
+		},
+		{
+			MethodName: "RenderGame",
+			Handler:    _GameService_RenderGame_Handler,
+		},
+		{
+			MethodName: "GetInvite",
+			Handler:    _GameService_GetInvite_Handler,
+		},
+		{
+			MethodName: "RevokeJoinCode",
+			Handler:    _GameService_RevokeJoinCode_Handler,
+		},
+		{
+			MethodName: "JoinByCode",
+			Handler:    _GameService_JoinByCode_Handler,
+		},
+		{
+			MethodName: "ChallengePlayer",
+			Handler:    _GameService_ChallengePlayer_Handler,
+		},
+		{
+			MethodName: "AcceptChallenge",
+			Handler:    _GameService_AcceptChallenge_Handler,
+		},
+		{
+			MethodName: "DeclineChallenge",
+			Handler:    _GameService_DeclineChallenge_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _GameService_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AnswerFriendRequest",
+			Handler:    _GameService_AnswerFriendRequest_Handler,
+		},
+		{
+			MethodName: "ListFriendRequests",
+			Handler:    _GameService_ListFriendRequests_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _GameService_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "ListFriends",
+			Handler:    _GameService_ListFriends_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _GameService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _GameService_SendChatMessage_Handler,
+		},
+		{
+			MethodName: "SendReaction",
+			Handler:    _GameService_SendReaction_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _GameService_CreateTournament_Handler,
+		},
+		{
+			MethodName: "ListTournaments",
+			Handler:    _GameService_ListTournaments_Handler,
+		},
+		{
+			MethodName: "RegisterForTournament",
+			Handler:    _GameService_RegisterForTournament_Handler,
+		},
+		{
+			MethodName: "StartTournament",
+			Handler:    _GameService_StartTournament_Handler,
+		},
+		{
+			MethodName: "GetStandings",
+			Handler:    _GameService_GetStandings_Handler,
+		},
+		{
+			MethodName: "MyActiveGames",
+			Handler:    _GameService_MyActiveGames_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -310,6 +1480,26 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GameService_GetGameState_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetNotifications",
+			Handler:       _GameService_GetNotifications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePresence",
+			Handler:       _GameService_SubscribePresence_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetChat",
+			Handler:       _GameService_GetChat_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeStandings",
+			Handler:       _GameService_SubscribeStandings_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/tictactoe/game.proto",
 }
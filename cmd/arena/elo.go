@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// z-score of a two-sided 95% confidence interval
+const z95 = 1.959964
+
+// eloDiff converts an expected score to an Elo rating difference.
+func eloDiff(score float64) float64 {
+	if score <= 0 {
+		return math.Inf(-1)
+	}
+	if score >= 1 {
+		return math.Inf(1)
+	}
+	return -400 * math.Log10(1/score-1)
+}
+
+// eloInterval returns the Elo difference implied by the results and its 95%
+// confidence interval, using the normal approximation of the mean score.
+func eloInterval(wins, draws, losses int) (elo, lower, upper float64) {
+	n := float64(wins + draws + losses)
+	if n == 0 {
+		return 0, math.Inf(-1), math.Inf(1)
+	}
+
+	score := (float64(wins) + float64(draws)/2) / n
+	variance := (float64(wins)*math.Pow(1-score, 2) +
+		float64(draws)*math.Pow(0.5-score, 2) +
+		float64(losses)*math.Pow(score, 2)) / n
+	margin := z95 * math.Sqrt(variance/n)
+
+	return eloDiff(score), eloDiff(score - margin), eloDiff(score + margin)
+}
+
+// likelihoodOfSuperiority is the probability that the first engine is
+// stronger, based on decisive games only.
+func likelihoodOfSuperiority(wins, losses int) float64 {
+	if wins+losses == 0 {
+		return 0.5
+	}
+	return 0.5 * (1 + math.Erf(float64(wins-losses)/math.Sqrt(2*float64(wins+losses))))
+}
+
+func formatElo(v float64) string {
+	if math.IsInf(v, 0) {
+		if v > 0 {
+			return "+inf"
+		}
+		return "-inf"
+	}
+	return fmt.Sprintf("%+.1f", v)
+}
@@ -0,0 +1,240 @@
+// Arena plays matches between two bot engines and reports the Elo difference:
+//
+//	arena -a mcts -b exec:./engine -size 15 -games 1000 -movetime 200ms
+//
+// Engines are bot names from the server's registry or "exec:<path> [args...]"
+// for an external engine speaking the protocol from internal/engine.
+package main
+
+import (
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/bot/mcts"
+	"TicTacToe/internal/engine"
+	"TicTacToe/internal/game"
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	engineA     = flag.String("a", "mcts", "first engine")
+	engineB     = flag.String("b", "random", "second engine")
+	games       = flag.Int("games", 1000, "number of games to play")
+	size        = flag.Int("size", 3, "board size")
+	winLength   = flag.Int("win", 0, "pieces in a row needed to win, defaults to min(size, 5)")
+	moveTime    = flag.Duration("movetime", 100*time.Millisecond, "thinking time per move")
+	openings    = flag.String("openings", "", "file with one opening per line, in position notation")
+	concurrency = flag.Int("concurrency", 1, "games played in parallel")
+	records     = flag.String("records", "", "file to write the game records to")
+	playouts    = flag.Int("mcts-playouts", 0, "playouts per move for the mcts bot, 0 to use the move time only")
+	mctsWorkers = flag.Int("mcts-workers", 1, "search threads per mcts bot")
+)
+
+func main() {
+	flag.Parse()
+	if *winLength == 0 {
+		*winLength = min(*size, 5)
+	}
+
+	registry := bot.NewDefaultRegistry()
+	registry.Register("mcts", mcts.Factory(mcts.Config{
+		Playouts: *playouts,
+		Workers:  *mctsWorkers,
+	}))
+
+	a := player{spec: *engineA, factory: factoryFor(registry, *engineA)}
+	b := player{spec: *engineB, factory: factoryFor(registry, *engineB)}
+
+	starts, err := loadOpenings(*openings, *size, *winLength)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var out io.Writer = io.Discard
+	if *records != "" {
+		f, err := os.Create(*records)
+		if err != nil {
+			log.Fatalf("failed to create records file: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	fmt.Printf("%s vs %s: %d games on %dx%d, %d in a row, %v per move, %d openings\n",
+		a.spec, b.spec, *games, *size, *size, *winLength, *moveTime, len(starts))
+
+	results := make(chan record)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < *concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := worker(a, b, starts, jobs, results); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+	go func() {
+		for i := 0; i < *games; i++ {
+			jobs <- i
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	var s summary
+	for r := range results {
+		s.add(r)
+		fmt.Fprintln(out, r)
+		if n := s.total(); n%100 == 0 {
+			fmt.Fprintf(os.Stderr, "%d/%d games, score %d-%d-%d\n", n, *games, s.wins, s.losses, s.draws)
+		}
+	}
+	s.print(a.spec, b.spec)
+}
+
+// worker owns one instance of each engine and plays games until jobs is closed.
+// Colours alternate so that every opening is played once from each side.
+func worker(a, b player, starts [][]string, jobs <-chan int, results chan<- record) error {
+	engA, err := a.factory(*size, *winLength)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", a.spec, err)
+	}
+	defer closeEngine(engA)
+	engB, err := b.factory(*size, *winLength)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", b.spec, err)
+	}
+	defer closeEngine(engB)
+
+	for i := range jobs {
+		opening := starts[(i/2)%len(starts)]
+		r := record{
+			number:  i + 1,
+			opening: game.FormatPosition(opening, *size, *winLength),
+			size:    *size,
+			aIsX:    i%2 == 0,
+		}
+
+		x, o := engA, engB
+		r.x, r.o = a.spec, b.spec
+		if !r.aIsX {
+			x, o = engB, engA
+			r.x, r.o = b.spec, a.spec
+		}
+		r.moves, r.winner, r.reason = playGame(x, o, opening, *size, *winLength, *moveTime)
+		results <- r
+	}
+	return nil
+}
+
+func factoryFor(registry *bot.Registry, spec string) bot.Factory {
+	if command, found := strings.CutPrefix(spec, "exec:"); found {
+		fields := strings.Fields(command)
+		if len(fields) == 0 {
+			log.Fatalf("missing engine path in %q", spec)
+		}
+		return engine.Factory(fields[0], fields[1:]...)
+	}
+	return func(size, winLength int) (bot.Engine, error) {
+		return registry.New(spec, size, winLength)
+	}
+}
+
+func closeEngine(e bot.Engine) {
+	if c, ok := e.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+// loadOpenings reads the openings file, or returns the empty board when there is none.
+func loadOpenings(path string, size, winLength int) ([][]string, error) {
+	if path == "" {
+		return [][]string{make([]string, size*size)}, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open openings: %w", err)
+	}
+	defer f.Close()
+
+	var starts [][]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		board, err := parseOpening(line, size, winLength)
+		if err != nil {
+			return nil, err
+		}
+		starts = append(starts, board)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read openings: %w", err)
+	}
+	if len(starts) == 0 {
+		return nil, errors.New("openings file is empty")
+	}
+	return starts, nil
+}
+
+// summary counts results from the first engine's point of view.
+type summary struct {
+	wins, draws, losses int
+	// results of the first engine playing X
+	xWins, xDraws, xLosses int
+}
+
+func (s *summary) add(r record) {
+	switch r.scoreA() {
+	case 1:
+		s.wins++
+	case 0.5:
+		s.draws++
+	default:
+		s.losses++
+	}
+	if !r.aIsX {
+		return
+	}
+	switch r.scoreA() {
+	case 1:
+		s.xWins++
+	case 0.5:
+		s.xDraws++
+	default:
+		s.xLosses++
+	}
+}
+
+func (s *summary) total() int {
+	return s.wins + s.draws + s.losses
+}
+
+func (s *summary) print(a, b string) {
+	n := s.total()
+	if n == 0 {
+		fmt.Println("no games played")
+		return
+	}
+	score := (float64(s.wins) + float64(s.draws)/2) / float64(n)
+	elo, lower, upper := eloInterval(s.wins, s.draws, s.losses)
+
+	fmt.Printf("Score of %s vs %s: %d - %d - %d  [%.3f] %d\n", a, b, s.wins, s.losses, s.draws, score, n)
+	fmt.Printf("%s playing X: %d - %d - %d\n", a, s.xWins, s.xLosses, s.xDraws)
+	fmt.Printf("%s playing O: %d - %d - %d\n", a, s.wins-s.xWins, s.losses-s.xLosses, s.draws-s.xDraws)
+	fmt.Printf("Elo difference: %s (95%% CI %s, %s)\n", formatElo(elo), formatElo(lower), formatElo(upper))
+	fmt.Printf("LOS: %.1f%%\n", 100*likelihoodOfSuperiority(s.wins, s.losses))
+}
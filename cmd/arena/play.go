@@ -0,0 +1,126 @@
+package main
+
+import (
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/utils"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// player is one side of the match as configured on the command line.
+type player struct {
+	spec    string
+	factory bot.Factory
+}
+
+// newGamer is implemented by engines that keep state between games, such as
+// engine subprocesses, and must be told when a new game starts.
+type newGamer interface {
+	NewGame(size, winLength int) error
+}
+
+// record is the outcome of a single arena game.
+type record struct {
+	number  int
+	x, o    string
+	opening string
+	size    int
+	moves   []int
+	winner  string // "X", "O" or "" for a draw
+	reason  string
+	// aIsX tells which side the first engine played
+	aIsX bool
+}
+
+// scoreA returns the first engine's score: 1, 0.5 or 0.
+func (r record) scoreA() float64 {
+	switch {
+	case r.winner == "":
+		return 0.5
+	case (r.winner == "X") == r.aIsX:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (r record) result() string {
+	switch r.winner {
+	case "X":
+		return "1-0"
+	case "O":
+		return "0-1"
+	default:
+		return "1/2-1/2"
+	}
+}
+
+func (r record) String() string {
+	moves := make([]string, len(r.moves))
+	for i, m := range r.moves {
+		moves[i] = game.CellName(m, r.size)
+	}
+	return fmt.Sprintf("#%d X=%q O=%q opening=%q moves=%s result=%s reason=%q",
+		r.number, r.x, r.o, r.opening, strings.Join(moves, ","), r.result(), r.reason)
+}
+
+// playGame plays one game from opening. An engine that fails to answer or
+// plays an illegal move loses the game.
+func playGame(x, o bot.Engine, opening []string, size, winLength int, moveTime time.Duration) (moves []int, winner, reason string) {
+	pos := bot.Position{Board: append([]string(nil), opening...), Size: size, WinLength: winLength}
+	engines := map[string]bot.Engine{"X": x, "O": o}
+
+	for _, side := range []struct {
+		engine   bot.Engine
+		opponent string
+	}{{x, "O"}, {o, "X"}} {
+		if ng, ok := side.engine.(newGamer); ok {
+			if err := ng.NewGame(size, winLength); err != nil {
+				return moves, side.opponent, fmt.Sprintf("engine failed to start a game: %v", err)
+			}
+		}
+	}
+
+	for {
+		if w := utils.CheckWin(pos.Board, size, winLength); w != "" {
+			return moves, w, "win"
+		}
+		if utils.IsBoardFull(pos.Board) {
+			return moves, "", "board full"
+		}
+
+		side := pos.SideToMove()
+		opponent := "O"
+		if side == "O" {
+			opponent = "X"
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), moveTime)
+		move, err := engines[side].BestMove(ctx, pos)
+		cancel()
+
+		switch {
+		case err != nil:
+			return moves, opponent, fmt.Sprintf("%s failed to move: %v", side, err)
+		case move < 0 || move >= len(pos.Board) || pos.Board[move] != "":
+			return moves, opponent, fmt.Sprintf("%s played illegal move %d", side, move)
+		}
+		pos.Board[move] = side
+		moves = append(moves, move)
+	}
+}
+
+// parseOpening reads an opening in position notation.
+func parseOpening(s string, size, winLength int) ([]string, error) {
+	pos, err := game.ParsePosition(s)
+	if err != nil {
+		return nil, err
+	}
+	if pos.Size != size || pos.WinLength != winLength {
+		return nil, fmt.Errorf("opening %q is not a %dx%d board with %d in a row", s, size, size, winLength)
+	}
+	return pos.Board, nil
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/pkg/client"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+var (
+	stopNotifications context.CancelFunc
+	// Challenge sent by the player that was not answered yet
+	sentChallengeID string
+)
+
+// Follow the player's notifications, replacing the previous stream
+func listenForNotifications(window fyne.Window) {
+	stopListeningForNotifications()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopNotifications = cancel
+	stream := gameClient.SubscribeNotifications(ctx)
+
+	go func() {
+		for {
+			select {
+			case n, ok := <-stream.Notifications():
+				if !ok {
+					if err := stream.Err(); err != nil {
+						log.Printf("Stopped receiving notifications: %v", err)
+					}
+					return
+				}
+				showNotification(window, n)
+			case err := <-stream.Errors():
+				log.Printf("Failed to receive notification: %v", err)
+			}
+		}
+	}()
+}
+
+func stopListeningForNotifications() {
+	if stopNotifications != nil {
+		stopNotifications()
+		stopNotifications = nil
+	}
+}
+
+func showNotification(window fyne.Window, n *tictactoev1.Notification) {
+	c := n.Challenge
+	switch n.Type {
+	case tictactoev1.NotificationType_CHALLENGE_RECEIVED:
+		message := fmt.Sprintf("%s challenges you to a game on %s", c.From.PlayerName, describeSettings(c.Settings))
+		fyne.CurrentApp().SendNotification(&fyne.Notification{Title: "Challenge", Content: message})
+		if inGame() {
+			message += "\nAccepting leaves your current game."
+		}
+		dialog.ShowCustomConfirm("Challenge", "Accept", "Decline", widget.NewLabel(message), func(accept bool) {
+			playSound(buttonSound)
+			if !accept {
+				if err := declineChallenge(c.ChallengeId); err != nil {
+					dialog.ShowError(err, window)
+				}
+				return
+			}
+			leaveCurrentGame()
+			if err := acceptChallenge(c.ChallengeId); err != nil {
+				dialog.ShowError(err, window)
+				return
+			}
+			showGameBoard(window)
+		}, window)
+
+	case tictactoev1.NotificationType_CHALLENGE_ACCEPTED:
+		sentChallengeID = ""
+		play := func() {
+			leaveCurrentGame()
+			gameData = n.Game
+			gameID = n.Game.Id
+			playerSymbol = "X"
+			showGameBoard(window)
+		}
+		message := fmt.Sprintf("%s accepted your challenge", c.To.PlayerName)
+		fyne.CurrentApp().SendNotification(&fyne.Notification{Title: "Challenge Accepted", Content: message})
+		if !inGame() {
+			play()
+			return
+		}
+		dialog.ShowConfirm("Challenge Accepted", message+". Leave the current game to play?", func(ok bool) {
+			if ok {
+				play()
+			}
+		}, window)
+
+	case tictactoev1.NotificationType_CHALLENGE_DECLINED:
+		message := fmt.Sprintf("%s declined your challenge", c.To.PlayerName)
+		if c.From.PlayerId != playerID {
+			message = fmt.Sprintf("%s withdrew their challenge", c.From.PlayerName)
+		} else {
+			sentChallengeID = ""
+		}
+		dialog.ShowInformation("Challenge", message, window)
+
+	case tictactoev1.NotificationType_FRIEND_REQUEST_RECEIVED:
+		fyne.CurrentApp().SendNotification(&fyne.Notification{
+			Title:   "Friend Request",
+			Content: fmt.Sprintf("%s wants to be your friend", n.Player.PlayerName),
+		})
+		showFriendRequest(window, n.Player)
+
+	case tictactoev1.NotificationType_TOURNAMENT_GAME_READY:
+		fyne.CurrentApp().SendNotification(&fyne.Notification{Title: "Tournament", Content: "Your next tournament game is ready"})
+		playTournamentGame(window, n.Game, "Your next tournament game is ready.")
+
+	case tictactoev1.NotificationType_TOURNAMENT_STARTING:
+		text := fmt.Sprintf("A tournament you registered for starts at %s", time.Unix(n.Deadline, 0).Format(time.Kitchen))
+		fyne.CurrentApp().SendNotification(&fyne.Notification{Title: "Tournament", Content: text})
+		dialog.ShowInformation("Tournament", text, window)
+
+	case tictactoev1.NotificationType_TOURNAMENT_FORFEIT_WARNING:
+		text := fmt.Sprintf("Join your tournament game by %s or you forfeit the match.", time.Unix(n.Deadline, 0).Format(time.Kitchen))
+		fyne.CurrentApp().SendNotification(&fyne.Notification{Title: "Tournament", Content: text})
+		if n.Game == nil || n.Game.Id == gameID {
+			dialog.ShowInformation("Tournament", text, window)
+			return
+		}
+		playTournamentGame(window, n.Game, text)
+
+	case tictactoev1.NotificationType_YOUR_TURN, tictactoev1.NotificationType_MOVE_REMINDER, tictactoev1.NotificationType_GAME_FINISHED:
+		// The board already shows what happened in the open game
+		if n.Game.Id == gameID && n.Type != tictactoev1.NotificationType_MOVE_REMINDER {
+			return
+		}
+		fyne.CurrentApp().SendNotification(&fyne.Notification{Title: "Correspondence", Content: correspondenceText(n)})
+		refreshMyGames()
+
+	case tictactoev1.NotificationType_FRIEND_REQUEST_ACCEPTED:
+		dialog.ShowInformation("Friends", fmt.Sprintf("%s accepted your friend request", n.Player.PlayerName), window)
+	}
+}
+
+// Whether the player is in a game that is not over
+func inGame() bool {
+	return gameID != "" && gameData != nil && gameData.Status != tictactoev1.GameStatus_FINISHED
+}
+
+// Leave the open game, or only close it if it is a correspondence game
+func leaveCurrentGame() {
+	switch {
+	case gameID == "":
+	case gameData != nil && gameData.DaysPerMove > 0:
+		closeGame()
+	default:
+		leaveGame()
+	}
+}
+
+func describeSettings(s *tictactoev1.CreateGameRequest) string {
+	if s.StartPosition != "" {
+		return "a custom position"
+	}
+	size := max(s.BoardSize, 3)
+	winLength := s.WinLength
+	if winLength == 0 {
+		winLength = min(size, 5)
+	}
+	return fmt.Sprintf("%d×%d, %d in a row", size, size, winLength)
+}
+
+// Screen to challenge a player by their ID, which can be filled in already
+func showChallengeScreen(window fyne.Window, opponentID string) {
+	title := widget.NewLabelWithStyle("Challenge a Player", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	playerEntry := widget.NewEntry()
+	playerEntry.SetPlaceHolder("Player ID")
+	playerEntry.SetText(opponentID)
+
+	boardSelect := widget.NewSelect([]string{"3×3", "4×4", "15×15 (five in a row)"}, nil)
+	boardSelect.SetSelected("3×3")
+
+	allowHintsCheck := widget.NewCheck("Allow hints", nil)
+
+	statusLabel := widget.NewLabel("")
+	statusLabel.Alignment = fyne.TextAlignCenter
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	var withdrawButton *widget.Button
+	sendButton := widget.NewButton("Send Challenge", func() {
+		playSound(buttonSound)
+		shape := boardOptions[boardSelect.Selected]
+		challenge, err := challengePlayer(strings.TrimSpace(playerEntry.Text), &tictactoev1.CreateGameRequest{
+			AllowHints: allowHintsCheck.Checked,
+			BoardSize:  shape[0],
+			WinLength:  shape[1],
+		})
+		if err != nil {
+			statusLabel.SetText(err.Error())
+			return
+		}
+		sentChallengeID = challenge.ChallengeId
+		statusLabel.SetText(fmt.Sprintf("Challenge sent to %s, waiting for an answer...", challenge.To.PlayerName))
+		withdrawButton.Show()
+	})
+
+	withdrawButton = widget.NewButton("Withdraw", func() {
+		playSound(buttonSound)
+		if sentChallengeID != "" {
+			if err := declineChallenge(sentChallengeID); err != nil {
+				log.Printf("Failed to withdraw challenge: %v", err)
+			}
+			sentChallengeID = ""
+		}
+		statusLabel.SetText("Challenge withdrawn")
+		withdrawButton.Hide()
+	})
+	withdrawButton.Hide()
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		showGameOptionsScreen(window)
+	})
+
+	content := container.NewVBox(
+		title,
+		playerEntry,
+		widget.NewForm(widget.NewFormItem("Board", boardSelect)),
+		allowHintsCheck,
+		sendButton,
+		statusLabel,
+		withdrawButton,
+		backButton,
+	)
+	window.SetContent(container.NewCenter(content))
+}
+
+// Send a challenge to another player
+func challengePlayer(playerIDParam string, settings *tictactoev1.CreateGameRequest) (*tictactoev1.Challenge, error) {
+	if playerIDParam == "" {
+		return nil, errors.New("enter the ID of the player to challenge")
+	}
+	challenge, err := gameClient.ChallengePlayer(context.Background(), playerIDParam, settings)
+	if err != nil {
+		return nil, fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	return challenge, nil
+}
+
+// Accept a challenge, which starts its game
+func acceptChallenge(challengeID string) error {
+	resp, err := gameClient.AcceptChallenge(context.Background(), challengeID)
+	if err != nil {
+		return fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	gameData = resp
+	gameID = resp.Id
+	playerSymbol = "O"
+	return nil
+}
+
+// Decline a received challenge or withdraw a sent one
+func declineChallenge(challengeID string) error {
+	if _, err := gameClient.DeclineChallenge(context.Background(), challengeID); err != nil {
+		return fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	return nil
+}
+
+// Opens a tournament game, asking first when another game is open
+func playTournamentGame(window fyne.Window, g *tictactoev1.GameData, text string) {
+	play := func() {
+		stopFollowingStandings()
+		openGame(window, g)
+	}
+	if !inGame() {
+		play()
+		return
+	}
+	dialog.ShowConfirm("Tournament", text+" Leave the current game to play?", func(ok bool) {
+		if ok {
+			play()
+		}
+	}, window)
+}
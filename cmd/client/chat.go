@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/pkg/client"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var stopChat context.CancelFunc
+
+// Chat panel of the game board, following the chat of the current game
+func chatPanel(window fyne.Window) fyne.CanvasObject {
+	title := widget.NewLabelWithStyle("Chat", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	messages := container.NewVBox()
+	scroll := container.NewVScroll(messages)
+
+	errorLabel := widget.NewLabel("")
+	errorLabel.Wrapping = fyne.TextWrapWord
+	errorLabel.Hide()
+
+	messageEntry := widget.NewEntry()
+	messageEntry.SetPlaceHolder("Say something nice")
+	send := func() {
+		text := strings.TrimSpace(messageEntry.Text)
+		if text == "" {
+			return
+		}
+		if _, err := gameClient.SendChatMessage(context.Background(), gameID, text); err != nil {
+			errorLabel.SetText(client.ErrorMessage(err))
+			errorLabel.Show()
+			return
+		}
+		errorLabel.Hide()
+		messageEntry.SetText("")
+	}
+	messageEntry.OnSubmitted = func(string) { send() }
+	sendButton := widget.NewButtonWithIcon("", theme.MailSendIcon(), func() {
+		playSound(buttonSound)
+		send()
+	})
+
+	listenForChat(func(msg *tictactoev1.ChatMessage) {
+		label := widget.NewLabel(formatChatMessage(msg))
+		label.Wrapping = fyne.TextWrapWord
+		messages.Add(label)
+		scroll.ScrollToBottom()
+	})
+
+	bottom := container.NewVBox(errorLabel, container.NewBorder(nil, nil, nil, sendButton, messageEntry))
+	return container.NewBorder(title, bottom, nil, nil, scroll)
+}
+
+func formatChatMessage(msg *tictactoev1.ChatMessage) string {
+	name := msg.From.PlayerName
+	if msg.From.PlayerId == playerID {
+		name = "You"
+	}
+	if msg.Channel == tictactoev1.ChatChannel_SPECTATORS {
+		name += " (spectator)"
+	}
+	return fmt.Sprintf("%s: %s", name, msg.Text)
+}
+
+// Follow the chat of the current game, replacing the previous stream
+func listenForChat(show func(*tictactoev1.ChatMessage)) {
+	stopListeningForChat()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopChat = cancel
+	stream := gameClient.SubscribeChat(ctx, gameID)
+
+	go func() {
+		for {
+			select {
+			case msg, ok := <-stream.Messages():
+				if !ok {
+					if err := stream.Err(); err != nil {
+						log.Printf("Stopped receiving chat messages: %v", err)
+					}
+					return
+				}
+				show(msg)
+			case err := <-stream.Errors():
+				log.Printf("Failed to receive chat message: %v", err)
+			}
+		}
+	}()
+}
+
+func stopListeningForChat() {
+	if stopChat != nil {
+		stopChat()
+		stopChat = nil
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/pkg/client"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Time controls offered when creating a game
+var daysPerMove = map[string]int32{
+	"Live":            0,
+	"1 day per move":  1,
+	"3 days per move": 3,
+	"7 days per move": 7,
+}
+
+var (
+	myGames   []*tictactoev1.GameData
+	myGamesMu sync.Mutex
+	// Reloads the list of games while My Games is shown
+	reloadMyGames func()
+)
+
+// Screen listing the games where it is the player's turn
+func showMyGamesScreen(window fyne.Window) {
+	title := widget.NewLabelWithStyle("Your Turn", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	emptyLabel := widget.NewLabel("It isn't your turn in any game")
+	emptyLabel.Alignment = fyne.TextAlignCenter
+
+	list := widget.NewList(
+		func() int {
+			myGamesMu.Lock()
+			defer myGamesMu.Unlock()
+			return len(myGames)
+		},
+		func() fyne.CanvasObject { return widget.NewLabel("Opponent name, 3×3, move by Mon 15:04") },
+		func(i widget.ListItemID, o fyne.CanvasObject) {
+			myGamesMu.Lock()
+			g := myGames[i]
+			myGamesMu.Unlock()
+			o.(*widget.Label).SetText(describeMyGame(g))
+		},
+	)
+	list.OnSelected = func(i widget.ListItemID) {
+		playSound(buttonSound)
+		myGamesMu.Lock()
+		g := myGames[i]
+		myGamesMu.Unlock()
+		reloadMyGames = nil
+		openGame(window, g)
+	}
+
+	reloadMyGames = func() {
+		games, err := gameClient.MyActiveGames(context.Background())
+		if err != nil {
+			log.Printf("Failed to list games: %v", client.ErrorMessage(err))
+			return
+		}
+		myGamesMu.Lock()
+		myGames = games
+		myGamesMu.Unlock()
+		if len(games) == 0 {
+			emptyLabel.Show()
+		} else {
+			emptyLabel.Hide()
+		}
+		list.UnselectAll()
+		list.Refresh()
+	}
+	reloadMyGames()
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		reloadMyGames = nil
+		showGameOptionsScreen(window)
+	})
+
+	window.SetContent(container.NewBorder(container.NewVBox(title, emptyLabel), backButton, nil, nil, list))
+}
+
+func refreshMyGames() {
+	if reloadMyGames != nil {
+		reloadMyGames()
+	}
+}
+
+// Open a game the player is seated in, closing the open one
+func openGame(window fyne.Window, g *tictactoev1.GameData) {
+	leaveCurrentGame()
+	gameData = g
+	gameID = g.Id
+	playerSymbol = "O"
+	if g.PlayerX.PlayerId == playerID {
+		playerSymbol = "X"
+	}
+	showGameBoard(window)
+}
+
+func describeMyGame(g *tictactoev1.GameData) string {
+	opponent := g.PlayerO
+	if opponent.GetPlayerId() == playerID {
+		opponent = g.PlayerX
+	}
+	text := fmt.Sprintf("%s, %d×%d", opponent.GetPlayerName(), g.BoardSize, g.BoardSize)
+	if g.MoveDeadline != 0 {
+		text += ", move by " + formatDeadline(g.MoveDeadline)
+	}
+	return text
+}
+
+func correspondenceText(n *tictactoev1.Notification) string {
+	opponent := n.Game.PlayerO
+	if opponent.GetPlayerId() == playerID {
+		opponent = n.Game.PlayerX
+	}
+	switch n.Type {
+	case tictactoev1.NotificationType_YOUR_TURN:
+		return fmt.Sprintf("Your turn against %s, move by %s", opponent.GetPlayerName(), formatDeadline(n.Deadline))
+	case tictactoev1.NotificationType_MOVE_REMINDER:
+		return fmt.Sprintf("Move against %s by %s or you lose on time", opponent.GetPlayerName(), formatDeadline(n.Deadline))
+	default:
+		if n.Game.Winner == "" {
+			return fmt.Sprintf("Your game against %s is drawn", opponent.GetPlayerName())
+		}
+		return fmt.Sprintf("Your game against %s is over, %s won", opponent.GetPlayerName(), n.Game.Winner)
+	}
+}
+
+func formatDeadline(unix int64) string {
+	return time.Unix(unix, 0).Format("Mon 15:04")
+}
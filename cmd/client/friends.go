@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/pkg/client"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var (
+	stopPresence context.CancelFunc
+	// Friends of the player sorted by name, kept up to date by the presence stream
+	friends   []*tictactoev1.Friend
+	friendsMu sync.Mutex
+	// Redraws the friends sidebar while it is shown
+	refreshFriends func()
+)
+
+// Keep the player online and follow the presence of their friends, replacing
+// the previous stream
+func trackPresence(window fyne.Window) {
+	stopTrackingPresence()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopPresence = cancel
+	go gameClient.KeepOnline(ctx)
+	stream := gameClient.SubscribePresence(ctx)
+
+	go func() {
+		for {
+			select {
+			case update, ok := <-stream.Updates():
+				if !ok {
+					if err := stream.Err(); err != nil {
+						log.Printf("Stopped receiving presence updates: %v", err)
+					}
+					return
+				}
+				updateFriend(update)
+			case err := <-stream.Errors():
+				log.Printf("Failed to receive presence update: %v", err)
+			}
+		}
+	}()
+
+	go showFriendRequests(window)
+}
+
+func stopTrackingPresence() {
+	if stopPresence != nil {
+		stopPresence()
+		stopPresence = nil
+	}
+	friendsMu.Lock()
+	friends = nil
+	friendsMu.Unlock()
+}
+
+// Apply a presence update to the friends list
+func updateFriend(update *tictactoev1.Friend) {
+	friendsMu.Lock()
+	i := slices.IndexFunc(friends, func(f *tictactoev1.Friend) bool {
+		return f.Player.PlayerId == update.Player.PlayerId
+	})
+	switch {
+	case update.Removed && i >= 0:
+		friends = slices.Delete(friends, i, i+1)
+	case update.Removed:
+	case i >= 0:
+		friends[i] = update
+	default:
+		friends = append(friends, update)
+		slices.SortFunc(friends, func(a, b *tictactoev1.Friend) int {
+			return strings.Compare(strings.ToLower(a.Player.PlayerName), strings.ToLower(b.Player.PlayerName))
+		})
+	}
+	friendsMu.Unlock()
+
+	if refreshFriends != nil {
+		refreshFriends()
+	}
+}
+
+// Ask about friend requests that arrived while the player was away
+func showFriendRequests(window fyne.Window) {
+	players, err := gameClient.ListFriendRequests(context.Background())
+	if err != nil {
+		log.Printf("Failed to list friend requests: %v", err)
+		return
+	}
+	for _, p := range players {
+		showFriendRequest(window, p)
+	}
+}
+
+func showFriendRequest(window fyne.Window, from *tictactoev1.PlayerData) {
+	message := fmt.Sprintf("%s wants to be your friend", from.PlayerName)
+	dialog.ShowCustomConfirm("Friend Request", "Accept", "Decline", widget.NewLabel(message), func(accept bool) {
+		playSound(buttonSound)
+		if err := gameClient.AnswerFriendRequest(context.Background(), from.PlayerId, accept); err != nil {
+			dialog.ShowError(fmt.Errorf("%v", client.ErrorMessage(err)), window)
+		}
+	}, window)
+}
+
+func presenceName(presence tictactoev1.Presence) string {
+	switch presence {
+	case tictactoev1.Presence_ONLINE:
+		return "Online"
+	case tictactoev1.Presence_IN_GAME:
+		return "In game"
+	default:
+		return "Offline"
+	}
+}
+
+// Sidebar listing the player's friends, with a button to challenge each
+func friendsSidebar(window fyne.Window) fyne.CanvasObject {
+	title := widget.NewLabelWithStyle("Friends", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	friendAt := func(i widget.ListItemID) *tictactoev1.Friend {
+		friendsMu.Lock()
+		defer friendsMu.Unlock()
+		if i >= len(friends) {
+			return nil
+		}
+		return friends[i]
+	}
+
+	list := widget.NewList(
+		func() int {
+			friendsMu.Lock()
+			defer friendsMu.Unlock()
+			return len(friends)
+		},
+		func() fyne.CanvasObject {
+			name := widget.NewLabel("Player name")
+			presence := widget.NewLabel("In game")
+			challenge := widget.NewButton("Challenge", nil)
+			remove := widget.NewButtonWithIcon("", theme.DeleteIcon(), nil)
+			return container.NewHBox(name, presence, layout.NewSpacer(), challenge, remove)
+		},
+		func(i widget.ListItemID, o fyne.CanvasObject) {
+			f := friendAt(i)
+			if f == nil {
+				return
+			}
+			row := o.(*fyne.Container).Objects
+			row[0].(*widget.Label).SetText(f.Player.PlayerName)
+			row[1].(*widget.Label).SetText(presenceName(f.Presence))
+			row[3].(*widget.Button).OnTapped = func() {
+				playSound(buttonSound)
+				showChallengeScreen(window, f.Player.PlayerId)
+			}
+			row[4].(*widget.Button).OnTapped = func() {
+				playSound(buttonSound)
+				message := fmt.Sprintf("Remove %s from your friends?", f.Player.PlayerName)
+				dialog.ShowConfirm("Remove Friend", message, func(ok bool) {
+					if !ok {
+						return
+					}
+					if err := gameClient.RemoveFriend(context.Background(), f.Player.PlayerId); err != nil {
+						dialog.ShowError(fmt.Errorf("%v", client.ErrorMessage(err)), window)
+					}
+				}, window)
+			}
+		},
+	)
+	refreshFriends = list.Refresh
+
+	friendEntry := widget.NewEntry()
+	friendEntry.SetPlaceHolder("Player ID")
+	addButton := widget.NewButton("Add Friend", func() {
+		playSound(buttonSound)
+		message, err := addFriend(strings.TrimSpace(friendEntry.Text))
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		friendEntry.SetText("")
+		dialog.ShowInformation("Friends", message, window)
+	})
+
+	return container.NewBorder(title, container.NewVBox(friendEntry, addButton), nil, nil, list)
+}
+
+// Send a friend request, which makes the players friends if the other player
+// asked too
+func addFriend(friendID string) (string, error) {
+	if friendID == "" {
+		return "", errors.New("enter the ID of the player to add")
+	}
+	accepted, err := gameClient.SendFriendRequest(context.Background(), friendID)
+	if err != nil {
+		return "", fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	if accepted {
+		return "You are now friends", nil
+	}
+	return "Friend request sent", nil
+}
@@ -1,17 +1,20 @@
 package main
 
 import (
+	"bytes"
 	"context"
-	"embed"
 	"fmt"
 	"image/color"
-	"io"
 	"log"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/pkg/client"
+	"TicTacToe/resources"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
@@ -23,13 +26,11 @@ import (
 	"github.com/faiface/beep"
 	"github.com/faiface/beep/speaker"
 	"github.com/faiface/beep/wav"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 )
 
 var (
-	client        tictactoev1.GameServiceClient
+	gameClient    *client.Client
+	stopUpdates   context.CancelFunc
 	gameData      *tictactoev1.GameData
 	gameID        string
 	playerName    string
@@ -41,16 +42,24 @@ var (
 	confettiImage fyne.Resource
 	xImage        fyne.Resource
 	oImage        fyne.Resource
-	//go:embed resources/*
-	resources embed.FS
+	// Invite link the client was started with, opened after login
+	pendingLink string
 )
 
 var localGameState struct {
-	board [9]string
+	board []string
+}
+
+// Board shapes offered when creating a game
+var boardOptions = map[string][2]int32{
+	"3×3":                   {3, 3},
+	"4×4":                   {4, 4},
+	"15×15 (five in a row)": {15, 5},
 }
 
 func main() {
-	myApp := app.New()
+	// The ID is needed to store preferences, like the saved servers
+	myApp := app.NewWithID("io.github.kuudori.tictactoe")
 	myApp.Settings().SetTheme(&myTheme{})
 	myWindow := myApp.NewWindow("TicTacToe")
 
@@ -61,10 +70,15 @@ func main() {
 	}
 
 	// Load resources
-	moveSound = loadSound("move.wav")
-	buttonSound = loadSound("button.wav")
-	xImage = loadResourceFromPath("x.png")
-	oImage = loadResourceFromPath("o.png")
+	moveSound = loadSound("move.wav", resources.MoveSound)
+	buttonSound = loadSound("button.wav", resources.ButtonSound)
+	xImage = fyne.NewStaticResource("x.png", resources.XImage)
+	oImage = fyne.NewStaticResource("o.png", resources.OImage)
+
+	// Invite links are passed as an argument by the system's URL handler
+	if len(os.Args) > 1 && strings.HasPrefix(os.Args[1], client.LinkScheme+"://") {
+		pendingLink = os.Args[1]
+	}
 
 	// Create the start screen
 	startScreen := createStartScreen(myWindow)
@@ -125,27 +139,52 @@ func (m myTheme) Variant() fyne.ThemeVariant {
 func createStartScreen(window fyne.Window) fyne.CanvasObject {
 	title := widget.NewLabelWithStyle("Welcome to TicTacToe!", fyne.TextAlignCenter, fyne.TextStyle{Bold: true, Italic: true})
 
+	prefs := fyne.CurrentApp().Preferences()
 	nameEntry := widget.NewEntry()
 	nameEntry.SetPlaceHolder("Enter your name")
+	nameEntry.SetText(prefs.String(prefLastName))
+
+	servers := loadServers()
+	serverSelect := widget.NewSelect(serverLabels(servers), nil)
+	serverSelect.SetSelectedIndex(selectedServer(servers))
 
 	loginButton := widget.NewButton("Login", func() {
 		playSound(buttonSound)
 		if nameEntry.Text != "" {
 			playerName = nameEntry.Text
-			connectToServer()
+			server := servers[serverSelect.SelectedIndex()]
+			if err := connectToServer(server); err != nil {
+				dialog.ShowError(err, window)
+				return
+			}
 			err := loginPlayer()
 			if err != nil {
 				dialog.ShowError(err, window)
 				return
 			}
+			listenForNotifications(window)
+			trackPresence(window)
+			prefs.SetString(prefLastName, playerName)
+			prefs.SetInt(prefSelectedServer, serverSelect.SelectedIndex())
+			if pendingLink != "" {
+				showJoinGameScreen(window)
+				return
+			}
 			showGameOptionsScreen(window)
 		}
 	})
 
+	settingsButton := widget.NewButton("Connection Settings", func() {
+		playSound(buttonSound)
+		showConnectionSettingsScreen(window)
+	})
+
 	content := container.NewVBox(
 		title,
 		nameEntry,
+		serverSelect,
 		loginButton,
+		settingsButton,
 	)
 	return container.NewCenter(content)
 }
@@ -165,6 +204,36 @@ func showGameOptionsScreen(window fyne.Window) {
 		showJoinGameScreen(window)
 	})
 
+	challengeButton := widget.NewButton("Challenge a Player", func() {
+		playSound(buttonSound)
+		showChallengeScreen(window, "")
+	})
+
+	// Other players need the ID to challenge this player
+	copyPlayerIDButton := widget.NewButtonWithIcon("Copy My Player ID", theme.ContentCopyIcon(), func() {
+		playSound(buttonSound)
+		window.Clipboard().SetContent(playerID)
+		fyne.CurrentApp().SendNotification(&fyne.Notification{
+			Title:   "Copied",
+			Content: "Player ID copied to clipboard",
+		})
+	})
+
+	puzzlesButton := widget.NewButton("Puzzles", func() {
+		playSound(buttonSound)
+		showPuzzleScreen(window, func() { showGameOptionsScreen(window) })
+	})
+
+	myGamesButton := widget.NewButton("My Games", func() {
+		playSound(buttonSound)
+		showMyGamesScreen(window)
+	})
+
+	tournamentsButton := widget.NewButton("Tournaments", func() {
+		playSound(buttonSound)
+		showTournamentsScreen(window)
+	})
+
 	backButton := widget.NewButton("Back", func() {
 		playSound(buttonSound)
 		window.SetContent(createStartScreen(window))
@@ -174,18 +243,48 @@ func showGameOptionsScreen(window fyne.Window) {
 		title,
 		createGameButton,
 		joinGameButton,
+		challengeButton,
+		myGamesButton,
+		tournamentsButton,
+		puzzlesButton,
+		copyPlayerIDButton,
 		backButton,
 	)
-	window.SetContent(container.NewCenter(content))
+	split := container.NewHSplit(container.NewCenter(content), friendsSidebar(window))
+	split.Offset = 0.6
+	window.SetContent(split)
 }
 
-// Screen to create a new game with a password
+// Screen to create a new game, with an optional password
 func showCreateGameScreen(window fyne.Window) {
 	title := widget.NewLabelWithStyle("Create a New Game", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 	//title.TextSize = 24
 
 	passwordEntry := widget.NewEntry()
-	passwordEntry.SetPlaceHolder("Enter password")
+	passwordEntry.SetPlaceHolder("Password (optional)")
+
+	allowHintsCheck := widget.NewCheck("Allow hints", nil)
+
+	startPositionEntry := widget.NewEntry()
+	startPositionEntry.SetPlaceHolder("Empty board, or e.g. 3/1x1/3 o 3 -")
+
+	boardSelect := widget.NewSelect([]string{"3×3", "4×4", "15×15 (five in a row)"}, nil)
+	boardSelect.SetSelected("3×3")
+
+	opponentSelect := widget.NewSelect(append([]string{"Human"}, listBots()...), nil)
+	opponentSelect.SetSelected("Human")
+
+	// Correspondence games are played over days, against people only
+	timeSelect := widget.NewSelect([]string{"Live", "1 day per move", "3 days per move", "7 days per move"}, nil)
+	timeSelect.SetSelected("Live")
+	opponentSelect.OnChanged = func(opponent string) {
+		if opponent == "Human" {
+			timeSelect.Enable()
+		} else {
+			timeSelect.SetSelected("Live")
+			timeSelect.Disable()
+		}
+	}
 
 	errorLabel := widget.NewLabel("")
 	errorLabel.Alignment = fyne.TextAlignCenter
@@ -193,19 +292,19 @@ func showCreateGameScreen(window fyne.Window) {
 
 	createButton := widget.NewButton("Create", func() {
 		playSound(buttonSound)
-		if len(passwordEntry.Text) < 4 {
-			errorLabel.SetText("Password must be at least 4 characters long")
+		botName := ""
+		if opponentSelect.Selected != "Human" {
+			botName = opponentSelect.Selected
+		}
+		errorLabel.Hide()
+		shape := boardOptions[boardSelect.Selected]
+		err := createGame(passwordEntry.Text, allowHintsCheck.Checked, shape[0], shape[1], botName, startPositionEntry.Text, daysPerMove[timeSelect.Selected])
+		if err != nil {
+			errorLabel.SetText(err.Error())
 			errorLabel.Show()
-		} else {
-			errorLabel.Hide()
-			err := createGame(passwordEntry.Text)
-			if err != nil {
-				errorLabel.SetText(err.Error())
-				errorLabel.Show()
-				return
-			}
-			showGameBoard(window)
+			return
 		}
+		showGameBoard(window)
 	})
 
 	backButton := widget.NewButton("Back", func() {
@@ -216,6 +315,13 @@ func showCreateGameScreen(window fyne.Window) {
 	content := container.NewVBox(
 		title,
 		passwordEntry,
+		widget.NewForm(
+			widget.NewFormItem("Board", boardSelect),
+			widget.NewFormItem("Opponent", opponentSelect),
+			widget.NewFormItem("Time", timeSelect),
+			widget.NewFormItem("Start position", startPositionEntry),
+		),
+		allowHintsCheck,
 		errorLabel,
 		createButton,
 		backButton,
@@ -229,10 +335,12 @@ func showJoinGameScreen(window fyne.Window) {
 	//title.TextSize = 24
 
 	gameIDEntry := widget.NewEntry()
-	gameIDEntry.SetPlaceHolder("Enter game ID")
+	gameIDEntry.SetPlaceHolder("Game ID, join code or link")
+	gameIDEntry.SetText(pendingLink)
+	pendingLink = ""
 
 	passwordEntry := widget.NewEntry()
-	passwordEntry.SetPlaceHolder("Enter game password")
+	passwordEntry.SetPlaceHolder("Game password, if any")
 
 	errorLabel := widget.NewLabel("")
 	errorLabel.Alignment = fyne.TextAlignCenter
@@ -240,19 +348,19 @@ func showJoinGameScreen(window fyne.Window) {
 
 	joinButton := widget.NewButton("Join", func() {
 		playSound(buttonSound)
-		if gameIDEntry.Text == "" || passwordEntry.Text == "" {
-			errorLabel.SetText("Game ID and Password cannot be empty")
+		target, err := client.ParseJoinTarget(gameIDEntry.Text)
+		if err != nil {
+			errorLabel.SetText(err.Error())
 			errorLabel.Show()
-		} else {
-			errorLabel.Hide()
-			err := joinGame(gameIDEntry.Text, passwordEntry.Text)
-			if err != nil {
-				errorLabel.SetText(fmt.Sprintf("Failed to join game: %v", err))
-				errorLabel.Show()
-				return
-			}
-			showGameBoard(window)
+			return
 		}
+		errorLabel.Hide()
+		if err := joinGame(target, passwordEntry.Text); err != nil {
+			errorLabel.SetText(fmt.Sprintf("Failed to join game: %v", err))
+			errorLabel.Show()
+			return
+		}
+		showGameBoard(window)
 	})
 
 	backButton := widget.NewButton("Back", func() {
@@ -273,8 +381,11 @@ func showJoinGameScreen(window fyne.Window) {
 
 // Main game board where the game is played
 func showGameBoard(window fyne.Window) {
-	boardButtons := make([]*widget.Button, 9)
-	for i := 0; i < 9; i++ {
+	size := boardSize()
+	localGameState.board = make([]string, size*size)
+
+	boardButtons := make([]*widget.Button, size*size)
+	for i := range boardButtons {
 		index := i
 		boardButtons[i] = widget.NewButton("", func() {
 			makeMove(index)
@@ -285,12 +396,15 @@ func showGameBoard(window fyne.Window) {
 		//boardButtons[i].SetMinSize(fyne.NewSize(100, 100))
 	}
 
+	// Transparent rectangles drawn over the cells, used to show hints
+	cellTints := make([]*canvas.Rectangle, size*size)
 	boardObjects := make([]fyne.CanvasObject, len(boardButtons))
 	for i, b := range boardButtons {
-		boardObjects[i] = b
+		cellTints[i] = canvas.NewRectangle(color.Transparent)
+		boardObjects[i] = container.NewStack(b, cellTints[i])
 	}
 
-	board := container.NewGridWithColumns(3, boardObjects...)
+	board := container.NewGridWithColumns(size, boardObjects...)
 	paddedBoard := container.NewPadded(board)
 
 	statusLabel := widget.NewLabel("Waiting for game to start...")
@@ -310,16 +424,76 @@ func showGameBoard(window fyne.Window) {
 		})
 	})
 
-	copyPasswordButton := widget.NewButtonWithIcon("Copy Password", theme.ContentCopyIcon(), func() {
+	hintButton := widget.NewButtonWithIcon("Hint", theme.SearchIcon(), func() {
 		playSound(buttonSound)
-		window.Clipboard().SetContent(gameData.Password)
+		err := showHint(cellTints)
+		if err != nil {
+			dialog.ShowError(err, window)
+		}
+	})
+	if !gameData.AllowHints || size != 3 {
+		hintButton.Hide()
+	}
+
+	// Puzzles open in their own window so the game keeps receiving updates
+	puzzlesButton := widget.NewButtonWithIcon("Puzzles", theme.GridIcon(), func() {
+		playSound(buttonSound)
+		puzzleWindow := fyne.CurrentApp().NewWindow("Puzzles")
+		showPuzzleScreen(puzzleWindow, puzzleWindow.Close)
+		puzzleWindow.Resize(fyne.NewSize(400, 400))
+		puzzleWindow.Show()
+	})
+
+	buttonContainer := container.NewHBox(copyIDButton, hintButton, puzzlesButton)
+
+	// The creator sees a short join code to read out to their opponent
+	joinCodeText := canvas.NewText("", color.White)
+	joinCodeText.TextSize = 40
+	joinCodeText.TextStyle = fyne.TextStyle{Bold: true, Monospace: true}
+	joinCodeText.Alignment = fyne.TextAlignCenter
+	joinCodeLabel := widget.NewLabel("")
+	joinCodeLabel.Alignment = fyne.TextAlignCenter
+	showJoinCode := func(invite *tictactoev1.Invite) {
+		joinCodeText.Text = invite.JoinCode
+		joinCodeText.Refresh()
+		expiresAt := time.Unix(invite.JoinCodeExpiresAt, 0)
+		joinCodeLabel.SetText("Join code, valid until " + expiresAt.Format("15:04"))
+	}
+
+	copyLinkButton := widget.NewButtonWithIcon("Copy Link", theme.ContentCopyIcon(), func() {
+		playSound(buttonSound)
+		window.Clipboard().SetContent(client.JoinLink(joinCodeText.Text))
 		fyne.CurrentApp().SendNotification(&fyne.Notification{
 			Title:   "Copied",
-			Content: "Password copied to clipboard",
+			Content: "Invite link copied to clipboard",
 		})
 	})
 
-	buttonContainer := container.NewHBox(copyIDButton, copyPasswordButton)
+	// Revoke the code, for example when it was shared with the wrong person
+	newCodeButton := widget.NewButtonWithIcon("New Code", theme.ViewRefreshIcon(), func() {
+		playSound(buttonSound)
+		invite, err := newJoinCode()
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		showJoinCode(invite)
+	})
+
+	joinCodeBox := container.NewVBox(
+		joinCodeText,
+		joinCodeLabel,
+		container.NewCenter(container.NewHBox(copyLinkButton, newCodeButton)),
+	)
+	joinCodeBox.Hide()
+	if playerSymbol == "X" && gameData.Status == tictactoev1.GameStatus_WAITING_FOR_PLAYER {
+		if invite, err := getInvite(); err != nil {
+			dialog.ShowError(err, window)
+		} else {
+			showJoinCode(invite)
+			joinCodeBox.Show()
+		}
+	}
 
 	leaveButton := widget.NewButton("Leave Game", func() {
 		playSound(buttonSound)
@@ -328,44 +502,43 @@ func showGameBoard(window fyne.Window) {
 	})
 	leaveButton.Importance = widget.DangerImportance
 
+	// A correspondence game can be put aside and picked up from My Games
+	backButton := widget.NewButton("Back to My Games", func() {
+		playSound(buttonSound)
+		closeGame()
+		showMyGamesScreen(window)
+	})
+	if gameData.DaysPerMove == 0 {
+		backButton.Hide()
+	}
+
 	playerInfo := widget.NewLabelWithStyle(fmt.Sprintf("Player: %s (%s)", playerName, playerSymbol), fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 
 	content := container.NewVBox(
 		playerInfo,
 		buttonContainer,
+		joinCodeBox,
 		paddedBoard,
+		reactionBar(window),
 		statusLabel,
 		currentPlayerLabel,
-		container.NewCenter(leaveButton),
+		container.NewCenter(container.NewHBox(backButton, leaveButton)),
 	)
 
-	window.SetContent(container.NewCenter(content))
+	split := container.NewHSplit(container.NewCenter(content), chatPanel(window))
+	split.Offset = 0.65
+	window.SetContent(split)
 
 	go listenForUpdates(func() {
-		updateGameBoard(boardButtons, statusLabel, currentPlayerLabel, window)
+		updateGameBoard(boardButtons, cellTints, joinCodeBox, statusLabel, currentPlayerLabel, window)
 	})
 }
 
-// Load image resources
-func loadResourceFromPath(path string) fyne.Resource {
-	resource, err := fyne.LoadResourceFromPath(path)
-	if err != nil {
-		log.Fatalf("Failed to load resource %s: %v", path, err)
-	}
-	return resource
-}
-
-// Load sound files into a beep.Buffer
-func loadSound(path string) *beep.Buffer {
-	f, err := os.Open(path)
+// Decode an embedded sound into a beep.Buffer
+func loadSound(name string, data []byte) *beep.Buffer {
+	streamer, format, err := wav.Decode(bytes.NewReader(data))
 	if err != nil {
-		log.Fatalf("Failed to open sound file %s: %v", path, err)
-	}
-	defer f.Close()
-
-	streamer, format, err := wav.Decode(f)
-	if err != nil {
-		log.Fatalf("Failed to decode sound file %s: %v", path, err)
+		log.Fatalf("Failed to decode sound file %s: %v", name, err)
 	}
 	defer streamer.Close()
 
@@ -392,19 +565,30 @@ func updateCell(button *widget.Button, cell string) {
 }
 
 // Update the game board UI
-func updateGameBoard(boardButtons []*widget.Button, statusLabel, currentPlayerLabel *widget.Label, window fyne.Window) {
+func updateGameBoard(boardButtons []*widget.Button, cellTints []*canvas.Rectangle, joinCodeBox fyne.CanvasObject, statusLabel, currentPlayerLabel *widget.Label, window fyne.Window) {
 	mu.Lock()
 	defer mu.Unlock()
 
 	if gameData == nil {
 		return
 	}
+	// Reactions leave the game as it was
+	if gameData.Event == tictactoev1.GameEvent_REACTION {
+		showReaction(window, gameData.Reaction)
+		return
+	}
+
+	// Hints are only valid for the position they were requested for
+	clearTints(cellTints)
 
 	isPlayerTurn := gameData.CurrentPlayer != nil && gameData.CurrentPlayer.PlayerId == playerID
 	isGameStarted := gameData.Status == tictactoev1.GameStatus_IN_PROGRESS
 
 	if gameData.Status == tictactoev1.GameStatus_WAITING_FOR_PLAYER {
 		isGameStarted = false
+	} else {
+		// The server forgets the join code once the game can't be joined
+		joinCodeBox.Hide()
 	}
 
 	for i, cell := range gameData.Board {
@@ -424,6 +608,9 @@ func updateGameBoard(boardButtons []*widget.Button, statusLabel, currentPlayerLa
 		statusLabel.SetText("Waiting for second player to join...")
 	} else {
 		statusText := fmt.Sprintf("Current player: %s", gameData.CurrentPlayer.PlayerName)
+		if gameData.MoveDeadline != 0 {
+			statusText += ", moves by " + formatDeadline(gameData.MoveDeadline)
+		}
 		statusLabel.SetText(statusText)
 	}
 
@@ -463,10 +650,60 @@ func showGameOverDialog(window fyne.Window, message string) {
 		window.Canvas().Overlays().Top().Hide()
 	})
 
+	moves := gameData.Moves
+	startPosition := gameData.StartPosition
+	analyzeButton := widget.NewButton("Analyze", func() {
+		window.Canvas().Overlays().Top().Hide()
+		showAnalysisScreen(window, startPosition, moves)
+	})
+	// The solver only covers the classic board
+	if boardSize() != 3 {
+		analyzeButton.Hide()
+	}
+
+	copyRecordButton := widget.NewButtonWithIcon("Copy Record", theme.ContentCopyIcon(), func() {
+		record, err := exportGame()
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		window.Clipboard().SetContent(record)
+		fyne.CurrentApp().SendNotification(&fyne.Notification{
+			Title:   "Copied",
+			Content: "Game record copied to clipboard",
+		})
+	})
+
+	exportGIFButton := widget.NewButtonWithIcon("Export GIF", theme.DocumentSaveIcon(), func() {
+		data, err := renderGame(tictactoev1.ImageFormat_GIF)
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		save := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+			if err != nil {
+				dialog.ShowError(err, window)
+				return
+			}
+			if w == nil {
+				return
+			}
+			defer w.Close()
+			if _, err := w.Write(data); err != nil {
+				dialog.ShowError(err, window)
+			}
+		}, window)
+		save.SetFileName("tictactoe-" + gameID + ".gif")
+		save.Show()
+	})
+
 	content := container.NewVBox(
 		title,
 		msg,
 		okButton,
+		analyzeButton,
+		copyRecordButton,
+		exportGIFButton,
 	)
 	modal := widget.NewModalPopUp(content, window.Canvas())
 	modal.Show()
@@ -487,13 +724,246 @@ func showConfetti(window fyne.Window) {
 	}()
 }
 
-// Connect to the game server
-func connectToServer() {
-	conn, err := grpc.Dial("localhost:44044", grpc.WithInsecure())
+// Show evaluation of the current position by tinting empty cells
+func showHint(cellTints []*canvas.Rectangle) error {
+	resp, err := gameClient.AnalyzePosition(context.Background(), &tictactoev1.AnalyzePositionRequest{
+		GameId: gameID,
+	})
+	if err != nil {
+		return fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+
+	for _, cell := range resp.Cells {
+		tint := cellTints[cell.Position]
+		tint.FillColor = outcomeColor(cell.Outcome)
+		tint.Refresh()
+	}
+	return nil
+}
+
+func clearTints(cellTints []*canvas.Rectangle) {
+	for _, tint := range cellTints {
+		tint.FillColor = color.Transparent
+		tint.Refresh()
+	}
+}
+
+func outcomeColor(outcome tictactoev1.Outcome) color.Color {
+	switch outcome {
+	case tictactoev1.Outcome_WIN:
+		return color.NRGBA{R: 46, G: 204, B: 113, A: 90}
+	case tictactoev1.Outcome_LOSS:
+		return color.NRGBA{R: 231, G: 76, B: 60, A: 90}
+	default:
+		return color.NRGBA{R: 241, G: 196, B: 15, A: 90}
+	}
+}
+
+// Screen that replays a finished game and flags blunders
+func showAnalysisScreen(window fyne.Window, startPosition string, moves []int32) {
+	title := widget.NewLabelWithStyle("Game Analysis", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	lines := container.NewVBox()
+	board := make([]string, 9)
+	if startPosition != "" {
+		start, err := game.ParsePosition(startPosition)
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		board = start.Board
+	}
+	for i, position := range moves {
+		symbol := sideToMove(board)
+
+		text, err := analyzeMove(board, position)
+		if err != nil {
+			text = err.Error()
+		}
+		lines.Add(widget.NewLabel(fmt.Sprintf("%d. %s %s — %s", i+1, symbol, cellName(position, 3), text)))
+
+		board[position] = symbol
+	}
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		showGameOptionsScreen(window)
+	})
+
+	content := container.NewVBox(
+		title,
+		lines,
+		backButton,
+	)
+	window.SetContent(container.NewCenter(content))
+}
+
+// Screen with a "win in N" puzzle; back is called when the player is done
+func showPuzzleScreen(window fyne.Window, back func()) {
+	puzzle, err := getPuzzle()
 	if err != nil {
-		log.Fatalf("Failed to connect: %v", err)
+		dialog.ShowError(err, window)
+		return
+	}
+	size := int(puzzle.BoardSize)
+
+	title := widget.NewLabelWithStyle(fmt.Sprintf("%s to move and win in %d", sideToMove(puzzle.Board), puzzle.MovesToWin), fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	ratingLabel := widget.NewLabel(fmt.Sprintf("Puzzle rating: %d    Your rating: %d", puzzle.Rating, puzzle.PlayerRating))
+	ratingLabel.Alignment = fyne.TextAlignCenter
+
+	resultLabel := widget.NewLabel("")
+	resultLabel.Alignment = fyne.TextAlignCenter
+	resultLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	boardButtons := make([]*widget.Button, size*size)
+	cellTints := make([]*canvas.Rectangle, size*size)
+	boardObjects := make([]fyne.CanvasObject, size*size)
+	for i := range boardButtons {
+		index := i
+		boardButtons[i] = widget.NewButton("", func() {
+			playSound(moveSound)
+			result, err := submitPuzzleSolution(puzzle.PuzzleId, int32(index))
+			if err != nil {
+				dialog.ShowError(err, window)
+				return
+			}
+			for _, b := range boardButtons {
+				b.Disable()
+			}
+
+			cellTints[result.Solution].FillColor = outcomeColor(tictactoev1.Outcome_WIN)
+			cellTints[result.Solution].Refresh()
+			if result.Correct {
+				resultLabel.SetText(fmt.Sprintf("Correct! Rating %d (%+d)", result.PlayerRating, result.RatingChange))
+				return
+			}
+			cellTints[index].FillColor = outcomeColor(tictactoev1.Outcome_LOSS)
+			cellTints[index].Refresh()
+			resultLabel.SetText(fmt.Sprintf("The winning move was %s. Rating %d (%+d)",
+				cellName(result.Solution, puzzle.BoardSize), result.PlayerRating, result.RatingChange))
+		})
+		boardButtons[i].Importance = widget.HighImportance
+		updateCell(boardButtons[i], puzzle.Board[i])
+		if puzzle.Board[i] != "" {
+			boardButtons[i].Disable()
+		}
+
+		cellTints[i] = canvas.NewRectangle(color.Transparent)
+		boardObjects[i] = container.NewStack(boardButtons[i], cellTints[i])
+	}
+	board := container.NewGridWithColumns(size, boardObjects...)
+
+	nextButton := widget.NewButton("Next Puzzle", func() {
+		playSound(buttonSound)
+		showPuzzleScreen(window, back)
+	})
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		back()
+	})
+
+	content := container.NewVBox(
+		title,
+		ratingLabel,
+		container.NewPadded(board),
+		resultLabel,
+		container.NewHBox(nextButton, backButton),
+	)
+	window.SetContent(container.NewCenter(content))
+}
+
+// Side to move on a board, X always moves first
+func sideToMove(board []string) string {
+	var xCount, oCount int
+	for _, cell := range board {
+		switch cell {
+		case "X":
+			xCount++
+		case "O":
+			oCount++
+		}
+	}
+	if xCount > oCount {
+		return "O"
+	}
+	return "X"
+}
+
+// Compare a played move with the best move available in the same position
+func analyzeMove(board []string, position int32) (string, error) {
+	resp, err := gameClient.AnalyzePosition(context.Background(), &tictactoev1.AnalyzePositionRequest{
+		Board: board,
+	})
+	if err != nil {
+		return "", fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+
+	best := tictactoev1.Outcome_LOSS
+	played := tictactoev1.Outcome_LOSS
+	for _, cell := range resp.Cells {
+		if outcomeRank(cell.Outcome) > outcomeRank(best) {
+			best = cell.Outcome
+		}
+		if cell.Position == position {
+			played = cell.Outcome
+		}
+	}
+
+	if outcomeRank(played) < outcomeRank(best) {
+		return fmt.Sprintf("blunder (%s → %s)", outcomeName(best), outcomeName(played)), nil
 	}
-	client = tictactoev1.NewGameServiceClient(conn)
+	return outcomeName(played), nil
+}
+
+func outcomeRank(outcome tictactoev1.Outcome) int {
+	switch outcome {
+	case tictactoev1.Outcome_WIN:
+		return 2
+	case tictactoev1.Outcome_DRAW:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func outcomeName(outcome tictactoev1.Outcome) string {
+	switch outcome {
+	case tictactoev1.Outcome_WIN:
+		return "win"
+	case tictactoev1.Outcome_LOSS:
+		return "loss"
+	default:
+		return "draw"
+	}
+}
+
+// Human-readable cell name, columns from a and rows from 1
+func cellName(position, size int32) string {
+	return fmt.Sprintf("%c%d", 'a'+position%size, position/size+1)
+}
+
+// Width of the current game's board
+func boardSize() int {
+	if gameData == nil || gameData.BoardSize == 0 {
+		return 3
+	}
+	return int(gameData.BoardSize)
+}
+
+// Connect to a game server, replacing the previous connection
+func connectToServer(server serverSettings) error {
+	c, err := connectTo(server)
+	if err != nil {
+		return err
+	}
+	if gameClient != nil {
+		stopListeningForNotifications()
+		stopTrackingPresence()
+		gameClient.Close()
+	}
+	gameClient = c
+	return nil
 }
 
 // Login the player and store the player ID
@@ -501,24 +971,73 @@ func loginPlayer() error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
 
-	resp, err := client.Login(ctx, &tictactoev1.LoginRequest{
-		PlayerName: playerName,
-	})
+	resp, err := gameClient.Login(ctx, playerName)
 	if err != nil {
-		return fmt.Errorf("Login failed: %v", extractErrorMessage(err))
+		return fmt.Errorf("Login failed: %v", client.ErrorMessage(err))
 	}
 	playerID = resp.PlayerId
 	return nil
 }
 
+// Fetch the bot difficulties offered by the server
+func listBots() []string {
+	names, err := gameClient.ListBots(context.Background())
+	if err != nil {
+		log.Printf("Failed to list bots: %v", client.ErrorMessage(err))
+		return nil
+	}
+	return names
+}
+
+// Fetch the finished game in record notation
+func exportGame() (string, error) {
+	text, err := gameClient.ExportGame(context.Background(), gameID)
+	if err != nil {
+		return "", fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	return text, nil
+}
+
+// Render the game as an image
+func renderGame(format tictactoev1.ImageFormat) ([]byte, error) {
+	resp, err := gameClient.RenderGame(context.Background(), &tictactoev1.RenderGameRequest{GameId: gameID, Format: format})
+	if err != nil {
+		return nil, fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	return resp.Data, nil
+}
+
+// Fetch a puzzle matching the player's rating
+func getPuzzle() (*tictactoev1.Puzzle, error) {
+	resp, err := gameClient.GetPuzzle(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	return resp, nil
+}
+
+// Submit the first move of a puzzle's solution
+func submitPuzzleSolution(puzzleID string, position int32) (*tictactoev1.PuzzleResult, error) {
+	resp, err := gameClient.SubmitPuzzleSolution(context.Background(), puzzleID, position)
+	if err != nil {
+		return nil, fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	return resp, nil
+}
+
 // Create a new game on the server
-func createGame(password string) error {
-	ctx := contextWithPlayerID()
-	resp, err := client.CreateGame(ctx, &tictactoev1.CreateGameRequest{
-		Password: password,
+func createGame(password string, allowHints bool, size, winLength int32, bot, startPosition string, daysPerMove int32) error {
+	resp, err := gameClient.CreateGame(context.Background(), &tictactoev1.CreateGameRequest{
+		Password:      password,
+		AllowHints:    allowHints,
+		BoardSize:     size,
+		WinLength:     winLength,
+		Bot:           bot,
+		StartPosition: startPosition,
+		DaysPerMove:   daysPerMove,
 	})
 	if err != nil {
-		return fmt.Errorf("%v", extractErrorMessage(err))
+		return fmt.Errorf("%v", client.ErrorMessage(err))
 	}
 	gameID = resp.Id
 	gameData = resp
@@ -529,94 +1048,95 @@ func createGame(password string) error {
 }
 
 // Join an existing game on the server
-func joinGame(gameIDParam, password string) error {
-	ctx := contextWithPlayerID()
-	resp, err := client.JoinGame(ctx, &tictactoev1.JoinGameRequest{
-		GameId:   gameIDParam,
-		Password: password,
-	})
+func joinGame(target client.JoinTarget, password string) error {
+	resp, err := gameClient.Join(context.Background(), target, password)
 	if err != nil {
-		return fmt.Errorf("%v", extractErrorMessage(err))
+		return fmt.Errorf("%v", client.ErrorMessage(err))
 	}
 	gameData = resp
-	gameID = gameIDParam
+	gameID = resp.Id
 
 	// Assign symbols
 	playerSymbol = "O"
 	return nil
 }
 
-// Leave the game
-func leaveGame() {
-	ctx := contextWithPlayerID()
-	_, err := client.LeaveGame(ctx, &tictactoev1.LeaveGameRequest{
-		GameId: gameID,
-	})
+// Get the join code of the game created by the player
+func getInvite() (*tictactoev1.Invite, error) {
+	invite, err := gameClient.GetInvite(context.Background(), gameID)
 	if err != nil {
-		log.Printf("Failed to leave game: %v", extractErrorMessage(err))
+		return nil, fmt.Errorf("%v", client.ErrorMessage(err))
 	}
-	gameID = ""
-	gameData = nil
-	playerSymbol = ""
+	return invite, nil
+}
+
+// Replace the join code of the game created by the player
+func newJoinCode() (*tictactoev1.Invite, error) {
+	if err := gameClient.RevokeJoinCode(context.Background(), gameID); err != nil {
+		return nil, fmt.Errorf("%v", client.ErrorMessage(err))
+	}
+	return getInvite()
 }
 
-// Create a context with player-id metadata
-func contextWithPlayerID() context.Context {
-	md := metadata.Pairs("player-id", playerID)
-	return metadata.NewOutgoingContext(context.Background(), md)
+// Leave the game
+func leaveGame() {
+	_, err := gameClient.LeaveGame(context.Background(), gameID)
+	if err != nil {
+		log.Printf("Failed to leave game: %v", client.ErrorMessage(err))
+	}
+	closeGame()
 }
 
-func extractErrorMessage(err error) string {
-	if grpcErr, ok := status.FromError(err); ok {
-		return grpcErr.Message()
+// Stop following the game without leaving it
+func closeGame() {
+	if stopUpdates != nil {
+		stopUpdates()
+		stopUpdates = nil
 	}
-	return err.Error()
+	stopListeningForChat()
+	gameID = ""
+	gameData = nil
+	playerSymbol = ""
 }
 
 // Make a move on the game board
 func makeMove(position int) {
-	ctx := contextWithPlayerID()
-	_, err := client.MakeMove(ctx, &tictactoev1.MoveRequest{
-		GameId:   gameID,
-		Position: int32(position),
-	})
+	_, err := gameClient.MakeMove(context.Background(), gameID, int32(position))
 	if err != nil {
 		fyne.CurrentApp().SendNotification(&fyne.Notification{
 			Title:   "Move Failed",
-			Content: fmt.Sprintf("%v", extractErrorMessage(err)),
+			Content: fmt.Sprintf("%v", client.ErrorMessage(err)),
 		})
 	} else {
 		playSound(moveSound)
 	}
 }
 
-// Listen for updates from the server
+// Listen for updates from the server until the game is left
 func listenForUpdates(updateUI func()) {
-	ctx := contextWithPlayerID()
-	stream, err := client.GetGameState(ctx, &tictactoev1.GameRequest{GameId: gameID})
-	if err != nil {
-		log.Fatalf("Failed to get game state: %v", err)
-	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stopUpdates = cancel
+	sub := gameClient.Subscribe(ctx, gameID)
 
 	for {
-		update, err := stream.Recv()
-		if err == io.EOF {
-			return
-		}
-		if err != nil {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				if err := sub.Err(); err != nil {
+					log.Printf("Stopped receiving updates: %v", err)
+				}
+				return
+			}
+			mu.Lock()
+			gameData = update
+			mu.Unlock()
+			updateUI()
+		case err := <-sub.Errors():
 			log.Printf("Failed to receive update: %v", err)
 			fyne.CurrentApp().SendNotification(&fyne.Notification{
 				Title:   "Connection Lost",
 				Content: "Attempting to reconnect...",
 			})
-			time.Sleep(time.Second * 2)
-			// Attempt to reconnect
-			go listenForUpdates(updateUI)
-			return
 		}
-		mu.Lock()
-		gameData = update
-		mu.Unlock()
-		updateUI()
 	}
 }
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"math/rand/v2"
+	"time"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/pkg/client"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Reactions in the order of their buttons
+var reactions = []struct {
+	reaction tictactoev1.ReactionType
+	text     string
+}{
+	{tictactoev1.ReactionType_THUMBS_UP, "👍"},
+	{tictactoev1.ReactionType_SURPRISED, "😮"},
+	{tictactoev1.ReactionType_LAUGHING, "😂"},
+	{tictactoev1.ReactionType_GOOD_MOVE, "Good move!"},
+	{tictactoev1.ReactionType_HURRY_UP, "Hurry up!"},
+}
+
+// Buttons to react during a game
+func reactionBar(window fyne.Window) fyne.CanvasObject {
+	bar := container.NewHBox()
+	for _, r := range reactions {
+		reaction := r.reaction
+		bar.Add(widget.NewButton(r.text, func() {
+			playSound(buttonSound)
+			if err := gameClient.SendReaction(context.Background(), gameID, reaction); err != nil {
+				fyne.CurrentApp().SendNotification(&fyne.Notification{
+					Title:   "Reaction Failed",
+					Content: fmt.Sprintf("%v", client.ErrorMessage(err)),
+				})
+			}
+		}))
+	}
+	return container.NewCenter(bar)
+}
+
+func reactionText(reaction tictactoev1.ReactionType) string {
+	for _, r := range reactions {
+		if r.reaction == reaction {
+			return r.text
+		}
+	}
+	return reaction.String()
+}
+
+// Float a reaction up over the board, like the confetti of showConfetti
+func showReaction(window fyne.Window, reaction *tictactoev1.Reaction) {
+	if reaction == nil {
+		return
+	}
+	text := canvas.NewText(reactionText(reaction.Type), color.White)
+	text.TextSize = 36
+	text.TextStyle = fyne.TextStyle{Bold: true}
+	name := canvas.NewText(reaction.From.GetPlayerName(), color.White)
+	name.Alignment = fyne.TextAlignCenter
+	bubble := container.NewVBox(text, name)
+	bubble.Resize(bubble.MinSize())
+
+	// Start below the middle at a random spot, so reactions in a row don't cover each other
+	size := window.Canvas().Size()
+	x := (size.Width-bubble.Size().Width)/2 + (rand.Float32()-0.5)*size.Width/3
+	start := fyne.NewPos(x, size.Height*2/3)
+	end := fyne.NewPos(x, size.Height/6)
+	bubble.Move(start)
+
+	overlay := container.NewWithoutLayout(bubble)
+	window.Canvas().Overlays().Add(overlay)
+	canvas.NewPositionAnimation(start, end, 2*time.Second, bubble.Move).Start()
+
+	// Remove the reaction once it floated up
+	go func() {
+		time.Sleep(2 * time.Second)
+		window.Canvas().Overlays().Remove(overlay)
+	}()
+}
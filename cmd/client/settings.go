@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"TicTacToe/pkg/client"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Keys of the connection settings in the Fyne preferences
+const (
+	prefServers        = "servers"
+	prefSelectedServer = "selectedServer"
+	prefLastName       = "lastName"
+)
+
+// Port of the server in the default configuration
+const defaultServerPort = 17077
+
+// A saved server the client can connect to
+type serverSettings struct {
+	Name   string `json:"name"`
+	Host   string `json:"host"`
+	Port   int    `json:"port"`
+	TLS    bool   `json:"tls"`
+	CAFile string `json:"ca_file,omitempty"` // PEM file to verify the server with instead of the system roots
+	// Client certificate for servers that require mutual TLS
+	CertFile string `json:"cert_file,omitempty"`
+	KeyFile  string `json:"key_file,omitempty"`
+}
+
+var localServer = serverSettings{Name: "Local", Host: "localhost", Port: defaultServerPort}
+
+func (s serverSettings) address() string {
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+}
+
+func (s serverSettings) label() string {
+	if s.Name != "" {
+		return s.Name + " (" + s.address() + ")"
+	}
+	return s.address()
+}
+
+// Load the saved servers, starting with the local one
+func loadServers() []serverSettings {
+	var servers []serverSettings
+	if data := fyne.CurrentApp().Preferences().String(prefServers); data != "" {
+		if err := json.Unmarshal([]byte(data), &servers); err != nil {
+			log.Printf("Failed to load saved servers: %v", err)
+		}
+	}
+	if len(servers) == 0 {
+		servers = []serverSettings{localServer}
+	}
+	return servers
+}
+
+func saveServers(servers []serverSettings) {
+	data, err := json.Marshal(servers)
+	if err != nil {
+		log.Printf("Failed to save servers: %v", err)
+		return
+	}
+	fyne.CurrentApp().Preferences().SetString(prefServers, string(data))
+}
+
+// Index of the server used at the last login
+func selectedServer(servers []serverSettings) int {
+	i := fyne.CurrentApp().Preferences().Int(prefSelectedServer)
+	if i < 0 || i >= len(servers) {
+		return 0
+	}
+	return i
+}
+
+func serverLabels(servers []serverSettings) []string {
+	labels := make([]string, len(servers))
+	for i, s := range servers {
+		labels[i] = s.label()
+	}
+	return labels
+}
+
+// Client options for connecting to a server
+func (s serverSettings) clientOptions() ([]client.Option, error) {
+	if !s.TLS {
+		return nil, nil
+	}
+	config, err := client.LoadTLSConfig(s.Host, client.TLSFiles{
+		CAFile:   s.CAFile,
+		CertFile: s.CertFile,
+		KeyFile:  s.KeyFile,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []client.Option{client.WithTLS(config)}, nil
+}
+
+// Connect to a server and check that it can be reached
+func connectTo(s serverSettings) (*client.Client, error) {
+	opts, err := s.clientOptions()
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(s.address(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if err := c.Ping(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Screen to add, edit and test saved servers
+func showConnectionSettingsScreen(window fyne.Window) {
+	title := widget.NewLabelWithStyle("Connection Settings", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	servers := loadServers()
+	current := selectedServer(servers)
+
+	nameEntry := widget.NewEntry()
+	hostEntry := widget.NewEntry()
+	portEntry := widget.NewEntry()
+	tlsCheck := widget.NewCheck("Use TLS", nil)
+	caEntry := fileEntry(window, "System certificates")
+	certEntry := fileEntry(window, "None")
+	keyEntry := fileEntry(window, "None")
+
+	fill := func(s serverSettings) {
+		nameEntry.SetText(s.Name)
+		hostEntry.SetText(s.Host)
+		portEntry.SetText(strconv.Itoa(s.Port))
+		tlsCheck.SetChecked(s.TLS)
+		caEntry.SetText(s.CAFile)
+		certEntry.SetText(s.CertFile)
+		keyEntry.SetText(s.KeyFile)
+	}
+	read := func() (serverSettings, error) {
+		s := serverSettings{
+			Name:     strings.TrimSpace(nameEntry.Text),
+			Host:     strings.TrimSpace(hostEntry.Text),
+			TLS:      tlsCheck.Checked,
+			CAFile:   strings.TrimSpace(caEntry.Text),
+			CertFile: strings.TrimSpace(certEntry.Text),
+			KeyFile:  strings.TrimSpace(keyEntry.Text),
+		}
+		if s.Host == "" {
+			return s, errors.New("enter the server's host")
+		}
+		port, err := strconv.Atoi(strings.TrimSpace(portEntry.Text))
+		if err != nil || port < 1 || port > 65535 {
+			return s, errors.New("port must be a number between 1 and 65535")
+		}
+		s.Port = port
+		return s, nil
+	}
+
+	serverSelect := widget.NewSelect(serverLabels(servers), nil)
+	serverSelect.OnChanged = func(string) {
+		if i := serverSelect.SelectedIndex(); i >= 0 {
+			current = i
+			fill(servers[i])
+		}
+	}
+	refresh := func() {
+		serverSelect.Options = serverLabels(servers)
+		serverSelect.SetSelectedIndex(current)
+	}
+	refresh()
+
+	saveButton := widget.NewButton("Save", func() {
+		playSound(buttonSound)
+		s, err := read()
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		servers[current] = s
+		saveServers(servers)
+		fyne.CurrentApp().Preferences().SetInt(prefSelectedServer, current)
+		refresh()
+	})
+
+	addButton := widget.NewButton("Add", func() {
+		playSound(buttonSound)
+		servers = append(servers, serverSettings{Name: "New server", Host: "localhost", Port: defaultServerPort})
+		current = len(servers) - 1
+		saveServers(servers)
+		refresh()
+	})
+
+	deleteButton := widget.NewButton("Delete", func() {
+		playSound(buttonSound)
+		if len(servers) == 1 {
+			dialog.ShowInformation("Delete", "At least one server is needed", window)
+			return
+		}
+		servers = append(servers[:current], servers[current+1:]...)
+		current = min(current, len(servers)-1)
+		saveServers(servers)
+		fyne.CurrentApp().Preferences().SetInt(prefSelectedServer, current)
+		refresh()
+	})
+
+	testButton := widget.NewButton("Test Connection", func() {
+		playSound(buttonSound)
+		s, err := read()
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		go func() {
+			c, err := connectTo(s)
+			if err != nil {
+				dialog.ShowError(err, window)
+				return
+			}
+			c.Close()
+			dialog.ShowInformation("Test Connection", "Connected to "+s.address(), window)
+		}()
+	})
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		window.SetContent(createStartScreen(window))
+	})
+
+	content := container.NewVBox(
+		title,
+		serverSelect,
+		widget.NewForm(
+			widget.NewFormItem("Name", nameEntry),
+			widget.NewFormItem("Host", hostEntry),
+			widget.NewFormItem("Port", portEntry),
+			widget.NewFormItem("", tlsCheck),
+			widget.NewFormItem("CA file", caEntry.withButton),
+			widget.NewFormItem("Client cert", certEntry.withButton),
+			widget.NewFormItem("Client key", keyEntry.withButton),
+		),
+		container.NewGridWithColumns(3, saveButton, addButton, deleteButton),
+		testButton,
+		backButton,
+	)
+	window.SetContent(container.NewCenter(content))
+}
+
+// An entry for a file path with a button to browse for the file
+type pathEntry struct {
+	*widget.Entry
+	withButton fyne.CanvasObject
+}
+
+func fileEntry(window fyne.Window, placeholder string) pathEntry {
+	entry := widget.NewEntry()
+	entry.SetPlaceHolder(placeholder)
+	browse := widget.NewButton("Browse", func() {
+		dialog.ShowFileOpen(func(reader fyne.URIReadCloser, err error) {
+			if err != nil || reader == nil {
+				return
+			}
+			defer reader.Close()
+			entry.SetText(reader.URI().Path())
+		}, window)
+	})
+	return pathEntry{Entry: entry, withButton: container.NewBorder(nil, nil, nil, browse, entry)}
+}
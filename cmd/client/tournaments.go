@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/pkg/client"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Stops following the standings shown by showStandingsScreen
+var stopStandings context.CancelFunc
+
+// Tournament formats offered when creating a tournament
+var tournamentFormats = map[string]tictactoev1.TournamentFormat{
+	"Round robin": tictactoev1.TournamentFormat_ROUND_ROBIN,
+	"Swiss":       tictactoev1.TournamentFormat_SWISS,
+	"Knockout":    tictactoev1.TournamentFormat_KNOCKOUT,
+}
+
+// Screen listing the tournaments on the server
+func showTournamentsScreen(window fyne.Window) {
+	title := widget.NewLabelWithStyle("Tournaments", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	tournaments, err := gameClient.ListTournaments(context.Background())
+	if err != nil {
+		dialog.ShowError(fmt.Errorf("%v", client.ErrorMessage(err)), window)
+	}
+
+	list := widget.NewList(
+		func() int { return len(tournaments) },
+		func() fyne.CanvasObject { return widget.NewLabel("Tournament name (Knockout, 16 players)") },
+		func(i widget.ListItemID, o fyne.CanvasObject) {
+			t := tournaments[i]
+			o.(*widget.Label).SetText(fmt.Sprintf("%s (%s, %d players, %s)", t.Name, formatName(t.Format), len(t.Players), statusName(t.Status)))
+		},
+	)
+	list.OnSelected = func(i widget.ListItemID) {
+		playSound(buttonSound)
+		showStandingsScreen(window, tournaments[i].Id)
+	}
+
+	createButton := widget.NewButton("Create Tournament", func() {
+		playSound(buttonSound)
+		showCreateTournamentScreen(window)
+	})
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		showGameOptionsScreen(window)
+	})
+
+	window.SetContent(container.NewBorder(title, container.NewVBox(createButton, backButton), nil, nil, list))
+}
+
+// Screen to create a tournament
+func showCreateTournamentScreen(window fyne.Window) {
+	title := widget.NewLabelWithStyle("Create a Tournament", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	nameEntry := widget.NewEntry()
+	nameEntry.SetPlaceHolder(playerName + "'s tournament")
+
+	formatSelect := widget.NewSelect([]string{"Round robin", "Swiss", "Knockout"}, nil)
+	formatSelect.SetSelected("Round robin")
+
+	boardSelect := widget.NewSelect([]string{"3×3", "4×4", "15×15 (five in a row)"}, nil)
+	boardSelect.SetSelected("3×3")
+
+	bestOfSelect := widget.NewSelect([]string{"1", "3", "5"}, nil)
+	bestOfSelect.SetSelected("1")
+
+	// Swiss tournaments play a set number of rounds
+	roundsEntry := widget.NewEntry()
+	roundsEntry.SetPlaceHolder("Enough to find a winner")
+
+	// Scheduled tournaments start by themselves
+	startsInEntry := widget.NewEntry()
+	startsInEntry.SetPlaceHolder("Started by you")
+	noShowEntry := widget.NewEntry()
+	noShowEntry.SetPlaceHolder("Never")
+	form := widget.NewForm(
+		widget.NewFormItem("Format", formatSelect),
+		widget.NewFormItem("Board", boardSelect),
+		widget.NewFormItem("Games per match", bestOfSelect),
+		widget.NewFormItem("Rounds", roundsEntry),
+		widget.NewFormItem("Starts in (minutes)", startsInEntry),
+		widget.NewFormItem("No-shows forfeit after (minutes)", noShowEntry),
+	)
+	formatSelect.OnChanged = func(format string) {
+		if format == "Swiss" {
+			roundsEntry.Enable()
+		} else {
+			roundsEntry.SetText("")
+			roundsEntry.Disable()
+		}
+	}
+	formatSelect.OnChanged(formatSelect.Selected)
+
+	createButton := widget.NewButton("Create", func() {
+		playSound(buttonSound)
+		shape := boardOptions[boardSelect.Selected]
+		bestOf, _ := strconv.Atoi(bestOfSelect.Selected)
+		rounds, err := strconv.Atoi(strings.TrimSpace(roundsEntry.Text))
+		if err != nil && roundsEntry.Text != "" {
+			dialog.ShowError(errors.New("rounds must be a number"), window)
+			return
+		}
+		startsIn, err := strconv.Atoi(strings.TrimSpace(startsInEntry.Text))
+		if err != nil && startsInEntry.Text != "" {
+			dialog.ShowError(errors.New("the start must be a number of minutes"), window)
+			return
+		}
+		noShow, err := strconv.Atoi(strings.TrimSpace(noShowEntry.Text))
+		if err != nil && noShowEntry.Text != "" {
+			dialog.ShowError(errors.New("the no-show time must be a number of minutes"), window)
+			return
+		}
+		var startsAt int64
+		if startsIn > 0 {
+			startsAt = time.Now().Add(time.Duration(startsIn) * time.Minute).Unix()
+		}
+		t, err := gameClient.CreateTournament(context.Background(), &tictactoev1.CreateTournamentRequest{
+			Name:          strings.TrimSpace(nameEntry.Text),
+			Format:        tournamentFormats[formatSelect.Selected],
+			Settings:      &tictactoev1.CreateGameRequest{BoardSize: shape[0], WinLength: shape[1]},
+			BestOf:        int32(bestOf),
+			Rounds:        int32(rounds),
+			StartsAt:      startsAt,
+			NoShowMinutes: int32(noShow),
+		})
+		if err != nil {
+			dialog.ShowError(fmt.Errorf("%v", client.ErrorMessage(err)), window)
+			return
+		}
+		showStandingsScreen(window, t.Id)
+	})
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		showTournamentsScreen(window)
+	})
+
+	content := container.NewVBox(title, nameEntry, form, createButton, backButton)
+	window.SetContent(container.NewCenter(content))
+}
+
+// Screen with the standings and matches of a tournament, updated live
+func showStandingsScreen(window fyne.Window, tournamentID string) {
+	stopFollowingStandings()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopStandings = cancel
+	stream := gameClient.SubscribeStandings(ctx, tournamentID)
+
+	title := widget.NewLabelWithStyle("Tournament", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	infoLabel := widget.NewLabel("")
+	infoLabel.Alignment = fyne.TextAlignCenter
+
+	standingsText := widget.NewLabel("Loading...")
+	standingsText.TextStyle = fyne.TextStyle{Monospace: true}
+	matchesText := widget.NewLabel("")
+	matchesText.TextStyle = fyne.TextStyle{Monospace: true}
+
+	registerButton := widget.NewButton("Register", func() {
+		playSound(buttonSound)
+		if _, err := gameClient.RegisterForTournament(context.Background(), tournamentID); err != nil {
+			dialog.ShowError(fmt.Errorf("%v", client.ErrorMessage(err)), window)
+		}
+	})
+	startButton := widget.NewButton("Start", func() {
+		playSound(buttonSound)
+		if _, err := gameClient.StartTournament(context.Background(), tournamentID); err != nil {
+			dialog.ShowError(fmt.Errorf("%v", client.ErrorMessage(err)), window)
+		}
+	})
+	registerButton.Hide()
+	startButton.Hide()
+
+	// Other players need the ID to find the tournament
+	copyIDButton := widget.NewButton("Copy Tournament ID", func() {
+		playSound(buttonSound)
+		window.Clipboard().SetContent(tournamentID)
+	})
+
+	backButton := widget.NewButton("Back", func() {
+		playSound(buttonSound)
+		stopFollowingStandings()
+		showTournamentsScreen(window)
+	})
+
+	go func() {
+		for {
+			select {
+			case s, ok := <-stream.Updates():
+				if !ok {
+					if err := stream.Err(); err != nil {
+						log.Printf("Stopped receiving standings: %v", err)
+					}
+					return
+				}
+				t := s.Tournament
+				title.SetText(t.Name)
+				infoLabel.SetText(describeTournament(t))
+				standingsText.SetText(formatStandings(s))
+				matchesText.SetText(formatMatches(t))
+				registering := t.Status == tictactoev1.TournamentStatus_REGISTRATION
+				if registering && !isRegistered(t) {
+					registerButton.Show()
+				} else {
+					registerButton.Hide()
+				}
+				if registering && t.Creator.PlayerId == playerID {
+					startButton.Show()
+				} else {
+					startButton.Hide()
+				}
+			case err := <-stream.Errors():
+				log.Printf("Failed to receive standings: %v", err)
+			}
+		}
+	}()
+
+	buttons := container.NewVBox(registerButton, startButton, copyIDButton, backButton)
+	tables := container.NewVScroll(container.NewVBox(standingsText, matchesText))
+	window.SetContent(container.NewBorder(container.NewVBox(title, infoLabel), buttons, nil, nil, tables))
+}
+
+func stopFollowingStandings() {
+	if stopStandings != nil {
+		stopStandings()
+		stopStandings = nil
+	}
+}
+
+func isRegistered(t *tictactoev1.Tournament) bool {
+	for _, p := range t.Players {
+		if p.PlayerId == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+func describeTournament(t *tictactoev1.Tournament) string {
+	text := fmt.Sprintf("%s on %s, best of %d. %s", formatName(t.Format), describeSettings(t.Settings), t.BestOf, statusName(t.Status))
+	if t.Status == tictactoev1.TournamentStatus_RUNNING {
+		text += fmt.Sprintf(", round %d of %d", t.CurrentRound, t.Rounds)
+	}
+	if t.Status == tictactoev1.TournamentStatus_REGISTRATION && t.StartsAt != 0 {
+		text += ", starts at " + time.Unix(t.StartsAt, 0).Format(time.Kitchen)
+	}
+	if t.NoShowMinutes > 0 {
+		text += fmt.Sprintf(". Players who don't join their game within %d minutes forfeit", t.NoShowMinutes)
+	}
+	return text
+}
+
+func formatStandings(s *tictactoev1.Standings) string {
+	if len(s.Standings) == 0 {
+		return "No players registered yet"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-3s %-16s %5s %6s %6s %7s\n", "#", "Player", "Score", "Buchh", "S-B", "W/D/L")
+	for _, st := range s.Standings {
+		name := st.Player.PlayerName
+		if st.Eliminated {
+			name += " ✗"
+		}
+		fmt.Fprintf(&b, "%-3d %-16.16s %5.1f %6.1f %6.2f %2d/%d/%d\n",
+			st.Rank, name, st.Score, st.Buchholz, st.SonnebornBerger, st.Wins, st.Draws, st.Losses)
+	}
+	return b.String()
+}
+
+// List the matches of the current round
+func formatMatches(t *tictactoev1.Tournament) string {
+	var b strings.Builder
+	for _, m := range t.Matches {
+		if m.Round != t.CurrentRound {
+			continue
+		}
+		if m.PlayerO == nil {
+			fmt.Fprintf(&b, "%s has a bye\n", m.PlayerX.PlayerName)
+			continue
+		}
+		result := "playing"
+		if m.Finished && m.Winner != nil {
+			result = m.Winner.PlayerName + " won"
+		} else if m.Finished {
+			result = "drawn"
+		}
+		if m.Forfeit {
+			result += " by forfeit"
+		}
+		fmt.Fprintf(&b, "%s %d–%d %s (%s)\n", m.PlayerX.PlayerName, m.WinsX, m.WinsO, m.PlayerO.PlayerName, result)
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Round %d\n%s", t.CurrentRound, b.String())
+}
+
+func formatName(format tictactoev1.TournamentFormat) string {
+	for name, f := range tournamentFormats {
+		if f == format {
+			return name
+		}
+	}
+	return format.String()
+}
+
+func statusName(status tictactoev1.TournamentStatus) string {
+	switch status {
+	case tictactoev1.TournamentStatus_REGISTRATION:
+		return "Open for registration"
+	case tictactoev1.TournamentStatus_RUNNING:
+		return "Running"
+	default:
+		return "Finished"
+	}
+}
@@ -0,0 +1,153 @@
+// Reference engine for the external engine protocol described in internal/engine.
+// It plays perfectly on 3x3 boards and uses MCTS on anything larger.
+package main
+
+import (
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/bot/mcts"
+	"TicTacToe/internal/engine"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/solver"
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Safety margin kept from every time budget for process and pipe overhead
+const moveOverhead = 50 * time.Millisecond
+
+type session struct {
+	out    *bufio.Writer
+	outMu  sync.Mutex
+	engine bot.Engine
+	pos    bot.Position
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func main() {
+	s := &session{out: bufio.NewWriter(os.Stdout)}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "tictactoe":
+			s.reply("id name reference")
+			s.reply("id author TicTacToe")
+			s.reply("ready")
+		case "newgame":
+			s.newGame(fields[1:])
+		case "position":
+			s.position(fields[1:])
+		case "go":
+			s.think(fields[1:])
+		case "stop":
+			s.stop()
+		case "quit":
+			s.stop()
+			return
+		default:
+			s.reply("info unknown command %s", fields[0])
+		}
+	}
+	s.stop()
+}
+
+func (s *session) reply(format string, args ...any) {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+
+	fmt.Fprintf(s.out, format+"\n", args...)
+	s.out.Flush()
+}
+
+func (s *session) newGame(args []string) {
+	s.stop()
+	if len(args) != 2 {
+		s.reply("info newgame needs size and win length")
+		return
+	}
+	size, err1 := strconv.Atoi(args[0])
+	winLength, err2 := strconv.Atoi(args[1])
+	if err1 != nil || err2 != nil {
+		s.reply("info invalid newgame arguments")
+		return
+	}
+
+	s.pos = bot.Position{Board: make([]string, size*size), Size: size, WinLength: winLength}
+	if size == 3 {
+		s.engine = bot.Perfect{Solver: solver.New(size, winLength)}
+	} else {
+		s.engine = mcts.New(mcts.Config{Workers: runtime.NumCPU()})
+	}
+}
+
+func (s *session) position(args []string) {
+	s.stop()
+	if len(args) != 1 {
+		s.reply("info position needs a board")
+		return
+	}
+	board, size, err := engine.ParseBoard(args[0])
+	if err != nil || size != s.pos.Size {
+		s.reply("info invalid position")
+		return
+	}
+	s.pos.Board = board
+}
+
+// think searches in the background so that "stop" can still be read.
+func (s *session) think(args []string) {
+	s.stop()
+	if s.engine == nil {
+		s.reply("info go before newgame")
+		return
+	}
+
+	budget := 5 * time.Second
+	if len(args) == 2 && args[0] == "wtime" {
+		if ms, err := strconv.Atoi(args[1]); err == nil {
+			budget = time.Duration(ms) * time.Millisecond
+		}
+	}
+	budget = max(budget-moveOverhead, budget/2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), budget)
+	s.cancel = cancel
+	pos := s.pos
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+
+		move, err := s.engine.BestMove(ctx, pos)
+		if err != nil {
+			s.reply("info %v", err)
+			move, err = bot.Random{}.BestMove(ctx, pos)
+			if err != nil {
+				return
+			}
+		}
+		s.reply("bestmove %s", game.CellName(move, pos.Size))
+	}()
+}
+
+func (s *session) stop() {
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	s.wg.Wait()
+}
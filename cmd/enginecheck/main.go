@@ -0,0 +1,31 @@
+// Runs the engine protocol conformance checks against an engine executable:
+//
+//	enginecheck ./engine [args...]
+package main
+
+import (
+	"TicTacToe/internal/engine"
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: enginecheck <engine> [args...]")
+		os.Exit(2)
+	}
+
+	failed := 0
+	for _, r := range engine.CheckConformance(os.Args[1], os.Args[2:]...) {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("FAIL  %-24s %v\n", r.Name, r.Err)
+			continue
+		}
+		fmt.Printf("ok    %s\n", r.Name)
+	}
+	if failed > 0 {
+		fmt.Printf("%d checks failed\n", failed)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,67 @@
+// Generates the builtin puzzle set:
+//
+//	go run ./cmd/puzzlegen -out internal/puzzle/puzzles.json
+package main
+
+import (
+	"TicTacToe/internal/puzzle"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+)
+
+// Board shapes in the builtin set. Wins in one move are common in random
+// games, so they get their own small quota.
+var shapes = []puzzle.Options{
+	{Size: 3, WinLength: 3, Count: 10, MinMovesToWin: 1, MaxMovesToWin: 1, MinEmpty: 3, MaxEmpty: 9, Games: 5000},
+	{Size: 3, WinLength: 3, Count: 30, MinMovesToWin: 2, MaxMovesToWin: 3, MinEmpty: 3, MaxEmpty: 9, Games: 5000},
+	{Size: 4, WinLength: 3, Count: 10, MinMovesToWin: 1, MaxMovesToWin: 1, MinEmpty: 5, MaxEmpty: 12, Games: 5000},
+	{Size: 4, WinLength: 3, Count: 30, MinMovesToWin: 2, MaxMovesToWin: 3, MinEmpty: 5, MaxEmpty: 12, Games: 5000},
+	{Size: 4, WinLength: 4, Count: 10, MinMovesToWin: 1, MaxMovesToWin: 1, MinEmpty: 5, MaxEmpty: 12, Games: 5000},
+	{Size: 4, WinLength: 4, Count: 30, MinMovesToWin: 2, MaxMovesToWin: 4, MinEmpty: 5, MaxEmpty: 12, Games: 5000},
+	{Size: 5, WinLength: 4, Count: 10, MinMovesToWin: 1, MaxMovesToWin: 1, MinEmpty: 7, MaxEmpty: 13, Games: 2000},
+	{Size: 5, WinLength: 4, Count: 30, MinMovesToWin: 2, MaxMovesToWin: 4, MinEmpty: 7, MaxEmpty: 13, Games: 2000},
+}
+
+func main() {
+	out := flag.String("out", "internal/puzzle/puzzles.json", "output file")
+	seed := flag.Int64("seed", 1, "random seed")
+	flag.Parse()
+
+	var all []puzzle.Puzzle
+	for _, opts := range shapes {
+		start := time.Now()
+		opts.Seed = *seed
+		puzzles, err := puzzle.Generate(opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%dx%d, %d in a row: %d puzzles in %v\n",
+			opts.Size, opts.Size, opts.WinLength, len(puzzles), time.Since(start).Round(time.Millisecond))
+		all = append(all, puzzles...)
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].Rating < all[j].Rating })
+
+	// One puzzle per line keeps the file readable and diffs small.
+	data := []byte("[\n")
+	for i, p := range all {
+		line, err := json.Marshal(p)
+		if err != nil {
+			log.Fatal(err)
+		}
+		data = append(data, "  "...)
+		data = append(data, line...)
+		if i < len(all)-1 {
+			data = append(data, ',')
+		}
+		data = append(data, '\n')
+	}
+	data = append(data, ']')
+	if err := os.WriteFile(*out, append(data, '\n'), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
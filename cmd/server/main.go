@@ -19,7 +19,7 @@ func setupLogger() {
 func loadConfig() *config.Config {
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		slog.Error("Failed to load configuration: ", err)
+		slog.Error("Failed to load configuration", "error", err)
 	}
 	return cfg
 }
@@ -28,7 +28,11 @@ func main() {
 	setupLogger()
 	cfg := loadConfig()
 
-	application := app.New(cfg.GRPC.Port)
+	application, err := app.New(cfg)
+	if err != nil {
+		slog.Error("Failed to initialize application", "error", err)
+		os.Exit(1)
+	}
 
 	// start grpc server with goroutine
 	go func() {
@@ -38,9 +42,18 @@ func main() {
 		}
 	}()
 
+	// start http gateway with goroutine
+	go func() {
+		err := application.HTTPServer.Start()
+		if err != nil {
+			panic("could not start http server")
+		}
+	}()
+
 	// gracefully stop server on syscall
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 	<-stop
+	application.HTTPServer.Stop()
 	application.GrpcServer.Stop()
 }
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+type keyCode int
+
+const (
+	keyRune keyCode = iota
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyEnter
+	keyBackspace
+	keyTab
+	keyEsc
+	keyCtrlC
+)
+
+type key struct {
+	code keyCode
+	r    rune
+}
+
+// readKeys decodes keys from the raw terminal until reading fails.
+func readKeys(r io.Reader, keys chan<- key) {
+	buf := make([]byte, 64)
+	for {
+		n, err := r.Read(buf)
+		if err != nil {
+			close(keys)
+			return
+		}
+		for data := buf[:n]; len(data) > 0; {
+			k, size := decodeKey(data)
+			data = data[size:]
+			keys <- k
+		}
+	}
+}
+
+func decodeKey(data []byte) (key, int) {
+	switch data[0] {
+	case 0x1b:
+		// Arrows are ESC [ A-D, or ESC O A-D in application mode.
+		if len(data) >= 3 && (data[1] == '[' || data[1] == 'O') {
+			switch data[2] {
+			case 'A':
+				return key{code: keyUp}, 3
+			case 'B':
+				return key{code: keyDown}, 3
+			case 'C':
+				return key{code: keyRight}, 3
+			case 'D':
+				return key{code: keyLeft}, 3
+			}
+			// Skip other sequences up to their final byte.
+			for i := 2; i < len(data); i++ {
+				if data[i] >= 0x40 && data[i] <= 0x7e {
+					return key{code: keyRune}, i + 1
+				}
+			}
+			return key{code: keyRune}, len(data)
+		}
+		return key{code: keyEsc}, 1
+	case '\r', '\n':
+		return key{code: keyEnter}, 1
+	case 0x7f, 0x08:
+		return key{code: keyBackspace}, 1
+	case '\t':
+		return key{code: keyTab}, 1
+	case 0x03, 0x04:
+		return key{code: keyCtrlC}, 1
+	}
+	r, size := utf8.DecodeRune(data)
+	return key{code: keyRune, r: r}, size
+}
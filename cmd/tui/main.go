@@ -0,0 +1,59 @@
+// Tui is a terminal client for the game server, for playing over SSH or on
+// machines without a display or sound:
+//
+//	tui -addr localhost:17077 -name alice
+//
+// It needs neither cgo nor a GUI stack, unlike cmd/client.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+
+	"TicTacToe/pkg/client"
+)
+
+var (
+	addr     = flag.String("addr", "localhost:17077", "address of the game server")
+	name     = flag.String("name", os.Getenv("USER"), "player name, asked for when empty")
+	useTLS   = flag.Bool("tls", false, "connect with TLS, implied by the other TLS flags")
+	caFile   = flag.String("ca", "", "CA to verify the server with instead of the system roots")
+	certFile = flag.String("cert", "", "client certificate, for servers that require mutual TLS")
+	keyFile  = flag.String("key", "", "key of the client certificate")
+)
+
+func main() {
+	flag.Parse()
+
+	var opts []client.Option
+	if *useTLS || *caFile != "" || *certFile != "" || *keyFile != "" {
+		host, _, _ := net.SplitHostPort(*addr)
+		config, err := client.LoadTLSConfig(host, client.TLSFiles{CAFile: *caFile, CertFile: *certFile, KeyFile: *keyFile})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		opts = append(opts, client.WithTLS(config))
+	}
+
+	c, err := client.New(*addr, opts...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer c.Close()
+	gameClient = c
+
+	term, err := openTerminal()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer term.Close()
+
+	keys := make(chan key)
+	go readKeys(os.Stdin, keys)
+	newUI(*name).run(keys)
+}
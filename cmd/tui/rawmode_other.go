@@ -0,0 +1,13 @@
+//go:build !unix
+
+package main
+
+import "errors"
+
+type terminal struct{}
+
+func openTerminal() (*terminal, error) {
+	return nil, errors.New("the terminal client needs a Unix terminal, use cmd/client instead")
+}
+
+func (t *terminal) Close() {}
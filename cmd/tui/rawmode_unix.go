@@ -0,0 +1,45 @@
+//go:build unix
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// terminal switches stdin to raw mode, so keys arrive one at a time and
+// without echo, and restores it on close.
+type terminal struct {
+	fd    int
+	saved unix.Termios
+}
+
+func openTerminal() (*terminal, error) {
+	fd := int(os.Stdin.Fd())
+	termios, err := unix.IoctlGetTermios(fd, getTermios)
+	if err != nil {
+		return nil, fmt.Errorf("stdin is not a terminal: %w", err)
+	}
+	t := &terminal{fd: fd, saved: *termios}
+
+	raw := *termios
+	raw.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	raw.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	raw.Cflag &^= unix.CSIZE | unix.PARENB
+	raw.Cflag |= unix.CS8
+	raw.Cc[unix.VMIN] = 1
+	raw.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, setTermios, &raw); err != nil {
+		return nil, fmt.Errorf("failed to enable raw mode: %w", err)
+	}
+
+	os.Stdout.WriteString(altScreen + hideCursor)
+	return t, nil
+}
+
+func (t *terminal) Close() {
+	os.Stdout.WriteString(reset + showCursor + mainScreen)
+	unix.IoctlSetTermios(t.fd, setTermios, &t.saved)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/pkg/client"
+)
+
+var gameClient *client.Client
+
+func errorMessage(err error) error {
+	return errors.New(client.ErrorMessage(err))
+}
+
+func login(name string) error {
+	if _, err := gameClient.Login(context.Background(), name); err != nil {
+		return errorMessage(err)
+	}
+	return nil
+}
+
+func listBots() []string {
+	names, _ := gameClient.ListBots(context.Background())
+	return names
+}
+
+func createGame(size, winLength int32, bot, password string) (*tictactoev1.GameData, error) {
+	resp, err := gameClient.CreateGame(context.Background(), &tictactoev1.CreateGameRequest{
+		BoardSize: size,
+		WinLength: winLength,
+		Bot:       bot,
+		Password:  password,
+	})
+	if err != nil {
+		return nil, errorMessage(err)
+	}
+	return resp, nil
+}
+
+func joinGame(target client.JoinTarget, password string) (*tictactoev1.GameData, error) {
+	resp, err := gameClient.Join(context.Background(), target, password)
+	if err != nil {
+		return nil, errorMessage(err)
+	}
+	return resp, nil
+}
+
+func getInvite(gameID string) (*tictactoev1.Invite, error) {
+	invite, err := gameClient.GetInvite(context.Background(), gameID)
+	if err != nil {
+		return nil, errorMessage(err)
+	}
+	return invite, nil
+}
+
+func leaveGame(gameID string) {
+	gameClient.LeaveGame(context.Background(), gameID)
+}
+
+func makeMove(gameID string, position int) error {
+	if _, err := gameClient.MakeMove(context.Background(), gameID, int32(position)); err != nil {
+		return errorMessage(err)
+	}
+	return nil
+}
+
+func sendChatMessage(gameID, text string) error {
+	if _, err := gameClient.SendChatMessage(context.Background(), gameID, text); err != nil {
+		return errorMessage(err)
+	}
+	return nil
+}
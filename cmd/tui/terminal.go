@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"strings"
+)
+
+// ANSI escape sequences
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	hideCursor  = "\x1b[?25l"
+	showCursor  = "\x1b[?25h"
+	altScreen   = "\x1b[?1049h"
+	mainScreen  = "\x1b[?1049l"
+	reset       = "\x1b[0m"
+	bold        = "\x1b[1m"
+	dim         = "\x1b[2m"
+	reverse     = "\x1b[7m"
+	red         = "\x1b[31m"
+	green       = "\x1b[32m"
+	yellow      = "\x1b[33m"
+	cyan        = "\x1b[36m"
+	blueBack    = "\x1b[44m"
+)
+
+// draw replaces the screen with the given lines.
+func draw(lines []string) {
+	var b strings.Builder
+	b.WriteString(clearScreen)
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteString(reset + "\r\n")
+	}
+	os.Stdout.WriteString(b.String())
+}
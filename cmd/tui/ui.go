@@ -0,0 +1,674 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/pkg/client"
+)
+
+type screen int
+
+const (
+	loginScreen screen = iota
+	lobbyScreen
+	createScreen
+	joinScreen
+	gameScreen
+)
+
+var lobbyItems = []string{"Create game", "Join game", "Quit"}
+
+// Chat messages shown below the board
+const chatLines = 6
+
+// field is a line of a form: free text, or a choice cycled with left and right.
+type field struct {
+	label   string
+	value   string
+	options []string
+	choice  int
+	secret  bool
+}
+
+func (f *field) text() string {
+	if f.options != nil {
+		return f.options[f.choice]
+	}
+	return f.value
+}
+
+// ui owns all client state. It runs on a single goroutine, fed by the keys
+// and the game's update stream.
+type ui struct {
+	screen  screen
+	name    string
+	message string // Error or notice shown below the current screen
+
+	menu   int
+	fields []*field
+	focus  int
+
+	game       *tictactoev1.GameData
+	invite     *tictactoev1.Invite // Join code of the game, shown to its creator
+	cursor     int
+	sub        *client.Subscription
+	chat       *client.ChatStream
+	chatLog    []*tictactoev1.ChatMessage // Latest messages, oldest first
+	chatDraft  *string                    // Message being typed, nil when not chatting
+	stopStream context.CancelFunc
+	quit       bool
+}
+
+func newUI(name string) *ui {
+	u := &ui{}
+	u.showLogin()
+	if name != "" {
+		u.fields[0].value = name
+		u.submitLogin()
+	}
+	return u
+}
+
+func (u *ui) run(keys <-chan key) {
+	for !u.quit {
+		u.draw()
+		// Nil channels block, so there are no updates outside a game.
+		var updates <-chan *tictactoev1.GameData
+		var failures <-chan error
+		if u.sub != nil {
+			updates, failures = u.sub.Updates(), u.sub.Errors()
+		}
+		var messages <-chan *tictactoev1.ChatMessage
+		if u.chat != nil {
+			messages = u.chat.Messages()
+		}
+		select {
+		case k, ok := <-keys:
+			if !ok || k.code == keyCtrlC {
+				u.leaveGame()
+				return
+			}
+			u.handleKey(k)
+		case update, ok := <-updates:
+			if !ok {
+				if err := u.sub.Err(); err != nil {
+					u.message = "Stopped receiving updates: " + client.ErrorMessage(err)
+				}
+				u.sub = nil
+				continue
+			}
+			u.setGame(update)
+		case err := <-failures:
+			u.message = "Connection lost, reconnecting: " + client.ErrorMessage(err)
+		case msg, ok := <-messages:
+			if !ok {
+				u.chat = nil
+				continue
+			}
+			u.chatLog = append(u.chatLog, msg)
+			if len(u.chatLog) > chatLines {
+				u.chatLog = u.chatLog[len(u.chatLog)-chatLines:]
+			}
+		}
+	}
+	u.leaveGame()
+}
+
+func (u *ui) handleKey(k key) {
+	switch u.screen {
+	case loginScreen:
+		if u.editForm(k) {
+			u.submitLogin()
+		}
+	case lobbyScreen:
+		u.handleLobbyKey(k)
+	case createScreen:
+		if k.code == keyEsc {
+			u.showLobby()
+			return
+		}
+		sizeBefore := u.fields[0].choice
+		if u.editForm(k) {
+			u.submitCreate()
+			return
+		}
+		if u.fields[0].choice != sizeBefore {
+			u.fields[1] = winLengthField(u.fields[0].choice + 3)
+		}
+	case joinScreen:
+		if k.code == keyEsc {
+			u.showLobby()
+			return
+		}
+		if u.editForm(k) {
+			u.submitJoin()
+		}
+	case gameScreen:
+		u.handleGameKey(k)
+	}
+}
+
+// editForm applies a key to the focused field and reports whether the form
+// was submitted.
+func (u *ui) editForm(k key) bool {
+	f := u.fields[u.focus]
+	switch k.code {
+	case keyEnter:
+		return true
+	case keyUp:
+		u.focus = (u.focus + len(u.fields) - 1) % len(u.fields)
+	case keyDown, keyTab:
+		u.focus = (u.focus + 1) % len(u.fields)
+	case keyLeft:
+		if f.options != nil {
+			f.choice = (f.choice + len(f.options) - 1) % len(f.options)
+		}
+	case keyRight:
+		if f.options != nil {
+			f.choice = (f.choice + 1) % len(f.options)
+		}
+	case keyBackspace:
+		if f.options == nil && f.value != "" {
+			runes := []rune(f.value)
+			f.value = string(runes[:len(runes)-1])
+		}
+	case keyRune:
+		if f.options == nil && k.r >= ' ' {
+			f.value += string(k.r)
+		}
+	}
+	return false
+}
+
+func (u *ui) showLogin() {
+	u.screen = loginScreen
+	u.fields = []*field{{label: "Your name"}}
+	u.focus = 0
+}
+
+func (u *ui) submitLogin() {
+	name := strings.TrimSpace(u.fields[0].value)
+	if name == "" {
+		u.message = "Enter a name"
+		return
+	}
+	if err := login(name); err != nil {
+		u.message = "Login failed: " + err.Error()
+		return
+	}
+	u.name = name
+	u.message = ""
+	u.showLobby()
+}
+
+func (u *ui) showLobby() {
+	u.screen = lobbyScreen
+	u.menu = 0
+}
+
+func (u *ui) handleLobbyKey(k key) {
+	switch {
+	case k.code == keyUp || k.r == 'k':
+		u.menu = (u.menu + len(lobbyItems) - 1) % len(lobbyItems)
+	case k.code == keyDown || k.r == 'j' || k.code == keyTab:
+		u.menu = (u.menu + 1) % len(lobbyItems)
+	case k.code == keyEnter:
+		u.message = ""
+		switch lobbyItems[u.menu] {
+		case "Create game":
+			u.showCreate()
+		case "Join game":
+			u.showJoin()
+		case "Quit":
+			u.quit = true
+		}
+	case k.code == keyEsc || k.r == 'q':
+		u.quit = true
+	}
+}
+
+func (u *ui) showCreate() {
+	sizes := make([]string, 0, 17)
+	for n := 3; n <= 19; n++ {
+		sizes = append(sizes, fmt.Sprintf("%dx%d", n, n))
+	}
+	opponents := []string{"A friend"}
+	for _, bot := range listBots() {
+		opponents = append(opponents, "Bot ("+bot+")")
+	}
+
+	u.screen = createScreen
+	u.fields = []*field{
+		{label: "Board", options: sizes},
+		winLengthField(3),
+		{label: "Opponent", options: opponents},
+		{label: "Password (optional)", secret: true},
+	}
+	u.focus = 0
+}
+
+// winLengthField offers the win lengths of a board size, defaulting to five in a row.
+func winLengthField(size int) *field {
+	f := &field{label: "In a row"}
+	for n := 3; n <= size; n++ {
+		f.options = append(f.options, strconv.Itoa(n))
+	}
+	f.choice = min(size, 5) - 3
+	return f
+}
+
+func (u *ui) submitCreate() {
+	size := int32(u.fields[0].choice + 3)
+	winLength := int32(u.fields[1].choice + 3)
+	bot := ""
+	if opponent := u.fields[2].text(); strings.HasPrefix(opponent, "Bot (") {
+		bot = strings.TrimSuffix(strings.TrimPrefix(opponent, "Bot ("), ")")
+	}
+
+	g, err := createGame(size, winLength, bot, u.fields[3].value)
+	if err != nil {
+		u.message = "Failed to create game: " + err.Error()
+		return
+	}
+	u.message = ""
+	u.startGame(g)
+	if g.Status == tictactoev1.GameStatus_WAITING_FOR_PLAYER {
+		if u.invite, err = getInvite(g.Id); err != nil {
+			u.message = "Failed to get the join code: " + err.Error()
+		}
+	}
+}
+
+func (u *ui) showJoin() {
+	u.screen = joinScreen
+	u.fields = []*field{
+		{label: "Game id, code or link"},
+		{label: "Password (if any)", secret: true},
+	}
+	u.focus = 0
+}
+
+func (u *ui) submitJoin() {
+	target, err := client.ParseJoinTarget(u.fields[0].value)
+	if err != nil {
+		u.message = err.Error()
+		return
+	}
+	g, err := joinGame(target, u.fields[1].value)
+	if err != nil {
+		u.message = "Failed to join game: " + err.Error()
+		return
+	}
+	u.message = ""
+	u.startGame(g)
+}
+
+func (u *ui) startGame(g *tictactoev1.GameData) {
+	u.screen = gameScreen
+	u.game = g
+	u.invite = nil
+	u.cursor = len(g.Board) / 2
+
+	ctx, cancel := context.WithCancel(context.Background())
+	u.stopStream = cancel
+	u.sub = gameClient.Subscribe(ctx, g.Id)
+	u.chat = gameClient.SubscribeChat(ctx, g.Id)
+	u.chatLog = nil
+	u.chatDraft = nil
+}
+
+func (u *ui) setGame(g *tictactoev1.GameData) {
+	// Reactions leave the game as it was, they are only shown
+	if g.Event == tictactoev1.GameEvent_REACTION {
+		u.message = fmt.Sprintf("%s: %s", g.Reaction.GetFrom().GetPlayerName(), reactionText(g.Reaction.GetType()))
+		return
+	}
+	u.game = g
+	u.message = ""
+}
+
+func reactionText(reaction tictactoev1.ReactionType) string {
+	switch reaction {
+	case tictactoev1.ReactionType_THUMBS_UP:
+		return "👍"
+	case tictactoev1.ReactionType_SURPRISED:
+		return "😮"
+	case tictactoev1.ReactionType_LAUGHING:
+		return "😂"
+	case tictactoev1.ReactionType_GOOD_MOVE:
+		return "Good move!"
+	case tictactoev1.ReactionType_HURRY_UP:
+		return "Hurry up!"
+	}
+	return reaction.String()
+}
+
+// leaveGame stops following the current game, leaving it if it is not over.
+func (u *ui) leaveGame() {
+	if u.game == nil {
+		return
+	}
+	u.stopStream()
+	u.sub = nil
+	u.chat = nil
+	if u.game.Status != tictactoev1.GameStatus_FINISHED {
+		leaveGame(u.game.Id)
+	}
+	u.game = nil
+}
+
+func (u *ui) handleGameKey(k key) {
+	if u.chatDraft != nil {
+		u.handleChatKey(k)
+		return
+	}
+	if k.r == 'c' && u.chat != nil {
+		u.chatDraft = new(string)
+		return
+	}
+
+	g := u.game
+	size := int(g.BoardSize)
+	if g.Status == tictactoev1.GameStatus_FINISHED {
+		if k.code == keyEnter || k.code == keyEsc || k.r == 'q' {
+			u.leaveGame()
+			u.showLobby()
+		}
+		return
+	}
+
+	switch {
+	case k.code == keyUp || k.r == 'k':
+		if u.cursor >= size {
+			u.cursor -= size
+		}
+	case k.code == keyDown || k.r == 'j':
+		if u.cursor+size < len(g.Board) {
+			u.cursor += size
+		}
+	case k.code == keyLeft || k.r == 'h':
+		if u.cursor%size > 0 {
+			u.cursor--
+		}
+	case k.code == keyRight || k.r == 'l':
+		if u.cursor%size < size-1 {
+			u.cursor++
+		}
+	case k.code == keyEnter || k.r == ' ':
+		if err := makeMove(g.Id, u.cursor); err != nil {
+			u.message = err.Error()
+		}
+	case k.code == keyEsc || k.r == 'q':
+		u.leaveGame()
+		u.message = "You left the game"
+		u.showLobby()
+	}
+}
+
+// handleChatKey edits the message being typed, and sends it on enter.
+func (u *ui) handleChatKey(k key) {
+	switch k.code {
+	case keyEsc:
+		u.chatDraft = nil
+	case keyEnter:
+		if text := strings.TrimSpace(*u.chatDraft); text != "" {
+			if err := sendChatMessage(u.game.Id, text); err != nil {
+				u.message = "Message not sent: " + err.Error()
+				return
+			}
+		}
+		u.chatDraft = nil
+	case keyBackspace:
+		if runes := []rune(*u.chatDraft); len(runes) > 0 {
+			*u.chatDraft = string(runes[:len(runes)-1])
+		}
+	case keyRune:
+		if k.r >= ' ' {
+			*u.chatDraft += string(k.r)
+		}
+	}
+}
+
+func (u *ui) draw() {
+	lines := []string{bold + "TicTacToe" + reset, ""}
+	switch u.screen {
+	case loginScreen:
+		lines = append(lines, u.formLines()...)
+		lines = append(lines, "", dim+"enter: log in · ctrl-c: quit")
+	case lobbyScreen:
+		lines = append(lines, "Playing as "+bold+u.name+reset, "")
+		for i, item := range lobbyItems {
+			if i == u.menu {
+				lines = append(lines, reverse+" > "+item+" ")
+			} else {
+				lines = append(lines, "   "+item)
+			}
+		}
+		lines = append(lines, "", dim+"up/down: select · enter: choose · q: quit")
+	case createScreen:
+		lines = append(lines, "New game", "")
+		lines = append(lines, u.formLines()...)
+		lines = append(lines, "", dim+"up/down: field · left/right: change · enter: create · esc: back")
+	case joinScreen:
+		lines = append(lines, "Join game", "")
+		lines = append(lines, u.formLines()...)
+		lines = append(lines, "", dim+"up/down: field · enter: join · esc: back")
+	case gameScreen:
+		lines = append(lines, u.gameLines()...)
+		lines = append(lines, u.chatPaneLines()...)
+	}
+	if u.message != "" {
+		lines = append(lines, "", yellow+u.message)
+	}
+	draw(lines)
+}
+
+func (u *ui) formLines() []string {
+	var lines []string
+	for i, f := range u.fields {
+		value := f.text()
+		if f.secret {
+			value = strings.Repeat("*", len([]rune(value)))
+		}
+		switch {
+		case f.options != nil:
+			value = "< " + value + " >"
+		case i == u.focus:
+			value += "_"
+		}
+		label := fmt.Sprintf("%-10s", f.label)
+		if i == u.focus {
+			lines = append(lines, reverse+" "+label+reset+" "+value)
+		} else {
+			lines = append(lines, " "+label+" "+value)
+		}
+	}
+	return lines
+}
+
+func (u *ui) mySymbol() string {
+	switch {
+	case u.game.PlayerX != nil && u.game.PlayerX.PlayerId == gameClient.PlayerID():
+		return "X"
+	case u.game.PlayerO != nil && u.game.PlayerO.PlayerId == gameClient.PlayerID():
+		return "O"
+	}
+	return ""
+}
+
+func (u *ui) gameLines() []string {
+	g := u.game
+	me := u.mySymbol()
+	opponent := "?"
+	if me == "X" && g.PlayerO != nil {
+		opponent = g.PlayerO.PlayerName
+	} else if me == "O" && g.PlayerX != nil {
+		opponent = g.PlayerX.PlayerName
+	}
+
+	lines := []string{
+		fmt.Sprintf("You play %s against %s · %dx%d, %d in a row", me, opponent, g.BoardSize, g.BoardSize, g.WinLength),
+		"",
+	}
+	lines = append(lines, u.boardLines()...)
+	lines = append(lines, "")
+
+	switch g.Status {
+	case tictactoev1.GameStatus_WAITING_FOR_PLAYER:
+		if u.invite == nil {
+			lines = append(lines, "Waiting for an opponent. Share the game id:", bold+g.Id)
+			break
+		}
+		lines = append(lines,
+			"Waiting for an opponent. Share the join code:",
+			"",
+			bold+"   "+strings.Join(strings.Split(u.invite.JoinCode, ""), " "),
+			"",
+			dim+"or the link "+client.JoinLink(u.invite.JoinCode),
+		)
+		if g.HasPassword {
+			lines = append(lines, dim+"which work without the password")
+		}
+	case tictactoev1.GameStatus_IN_PROGRESS:
+		if g.CurrentPlayer != nil && g.CurrentPlayer.PlayerId == gameClient.PlayerID() {
+			lines = append(lines, green+"Your move")
+		} else if g.CurrentPlayer != nil {
+			lines = append(lines, g.CurrentPlayer.PlayerName+" is thinking…")
+		}
+	case tictactoev1.GameStatus_FINISHED:
+		return append(lines, u.summaryLines()...)
+	}
+	return append(lines, "", dim+"arrows/hjkl: move · enter/space: place · c: chat · q: leave")
+}
+
+// chatPaneLines shows the latest chat messages and the message being typed.
+func (u *ui) chatPaneLines() []string {
+	if u.chat == nil && len(u.chatLog) == 0 {
+		return nil
+	}
+	lines := []string{"", bold + "Chat" + reset}
+	if len(u.chatLog) == 0 {
+		lines = append(lines, dim+"No messages yet")
+	}
+	for _, msg := range u.chatLog {
+		name := msg.From.GetPlayerName()
+		if msg.From.GetPlayerId() == gameClient.PlayerID() {
+			name = "You"
+		}
+		if msg.Channel == tictactoev1.ChatChannel_SPECTATORS {
+			name += " (spectator)"
+		}
+		lines = append(lines, bold+name+":"+reset+" "+msg.Text)
+	}
+	if u.chatDraft != nil {
+		lines = append(lines, reverse+" Say "+reset+" "+*u.chatDraft+"_", dim+"enter: send · esc: cancel")
+	}
+	return lines
+}
+
+func (u *ui) boardLines() []string {
+	g := u.game
+	size := int(g.BoardSize)
+	last := int32(-1)
+	if len(g.Moves) > 0 {
+		last = g.Moves[len(g.Moves)-1]
+	}
+	showCursor := g.Status == tictactoev1.GameStatus_IN_PROGRESS
+
+	header := "    "
+	for c := 0; c < size; c++ {
+		header += fmt.Sprintf(" %c ", 'a'+c)
+	}
+	lines := []string{dim + header}
+	for r := 0; r < size; r++ {
+		var b strings.Builder
+		fmt.Fprintf(&b, "%s%3d %s", dim, r+1, reset)
+		for c := 0; c < size; c++ {
+			i := r*size + c
+			style := ""
+			switch g.Board[i] {
+			case "X":
+				style = red + bold
+			case "O":
+				style = cyan + bold
+			}
+			if int32(i) == last {
+				style += blueBack
+			}
+			if showCursor && i == u.cursor {
+				style += reverse
+			}
+			piece := g.Board[i]
+			if piece == "" {
+				piece = "·"
+			}
+			b.WriteString(style + " " + piece + " " + reset)
+		}
+		lines = append(lines, b.String())
+	}
+	return lines
+}
+
+// summaryLines describes the result of a finished game and lists its moves.
+func (u *ui) summaryLines() []string {
+	g := u.game
+	var result string
+	switch {
+	case g.Event == tictactoev1.GameEvent_PLAYER_LEAVED:
+		result = "Your opponent left the game."
+	case g.Event == tictactoev1.GameEvent_TIMED_OUT && g.Winner == u.name:
+		result = green + "Your opponent ran out of time, you won!"
+	case g.Event == tictactoev1.GameEvent_TIMED_OUT:
+		result = red + g.Winner + " won on time."
+	case g.Winner == "":
+		result = "It's a draw!"
+	case g.Winner == u.name && u.mySymbol() != "":
+		result = green + "You won!"
+	default:
+		result = red + g.Winner + " won."
+	}
+
+	lines := []string{bold + "Game over. " + reset + result, ""}
+	lines = append(lines, fmt.Sprintf("%d moves:", len(g.Moves)))
+	lines = append(lines, moveList(g)...)
+	return append(lines, "", dim+"enter: back to the lobby · c: chat")
+}
+
+// moveList writes the moves in the notation of game records, wrapped to lines.
+func moveList(g *tictactoev1.GameData) []string {
+	const width = 60
+	var lines []string
+	var line strings.Builder
+
+	offset := 0
+	if start, err := game.ParsePosition(g.StartPosition); err == nil && start.ToMove == "O" {
+		offset = 1
+	}
+	for i, m := range g.Moves {
+		ply := i + offset
+		token := game.CellName(int(m), int(g.BoardSize))
+		switch {
+		case ply%2 == 0:
+			token = fmt.Sprintf("%d. %s", ply/2+1, token)
+		case i == 0:
+			token = "1... " + token
+		}
+		if line.Len() > 0 && line.Len()+len(token) >= width {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(token)
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
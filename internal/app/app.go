@@ -1,30 +1,107 @@
 package app
 
 import (
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/bot/mcts"
+	"TicTacToe/internal/certs"
+	"TicTacToe/internal/chat"
+	"TicTacToe/internal/clock"
+	"TicTacToe/internal/config"
+	"TicTacToe/internal/engine"
 	"TicTacToe/internal/grpc/game"
+	"TicTacToe/internal/notifier"
+	"TicTacToe/internal/puzzle"
 	"TicTacToe/internal/server/gameserver"
 	"TicTacToe/internal/server/grpcserver"
+	"TicTacToe/internal/server/httpserver"
 	storage "TicTacToe/internal/storage/inmem"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
 )
 
-// App represents the main application containing the game server and gRPC server.
+// App represents the main application containing the game server and the
+// gRPC and HTTP servers.
 type App struct {
 	GameServer *gameserver.GameServer
 	GrpcServer *grpcserver.GRPCServer
+	HTTPServer *httpserver.HTTPServer
 	port       int
 }
 
-// New initializes the App with the specified port.
-func New(port int) *App {
+// New initializes the App from the loaded configuration.
+func New(cfg *config.Config) (*App, error) {
+	bots := bot.NewDefaultRegistry()
+	bots.Register("mcts", mcts.Factory(mcts.Config{
+		Playouts:   cfg.Bot.MCTS.Playouts,
+		TimeBudget: cfg.Bot.MCTS.TimeBudget,
+		Workers:    cfg.Bot.MCTS.Workers,
+	}))
+	for _, e := range cfg.Bot.Engines {
+		bots.Register(e.Name, engine.Factory(e.Path, e.Args...))
+	}
+
+	puzzles, err := puzzle.Builtin()
+	if err != nil {
+		return nil, err
+	}
+	puzzleStorage := storage.NewPuzzleStorage()
+	for i := range puzzles {
+		if err := puzzleStorage.AddPuzzle(context.Background(), &puzzles[i]); err != nil {
+			return nil, fmt.Errorf("failed to load puzzle %s: %w", puzzles[i].ID, err)
+		}
+	}
+
 	gameStorage := storage.NewGameStorage()
-	gameSrv := gameserver.NewGameServer(gameStorage)
-	grpcSrv := grpcserver.NewGRPCServer(port, gameSrv)
+	gameSrv := gameserver.NewGameServer(gameStorage, puzzleStorage, storage.NewFriendStorage(), storage.NewTournamentStorage(), bots, cfg.Bot.MoveTime, gameserver.ChatSettings{
+		MaxLength:       cfg.Chat.MaxLength,
+		Limiter:         chat.NewLimiter(cfg.Chat.Burst, cfg.Chat.Interval),
+		Filter:          chat.NewBlocklist(cfg.Chat.Blocklist),
+		ReactionLimiter: chat.NewLimiter(cfg.Chat.ReactionBurst, cfg.Chat.ReactionInterval),
+	}, clock.Real(), newNotifier(cfg.Notifier))
+	tlsConfig, err := serverTLS(cfg.GRPC.TLS)
+	if err != nil {
+		return nil, err
+	}
+	grpcSrv := grpcserver.NewGRPCServer(cfg.GRPC.Port, gameSrv, tlsConfig)
 
 	game.Register(grpcSrv.Server, gameSrv)
+	httpSrv := httpserver.NewHTTPServer(cfg.HTTP.Port, gameSrv, game.NewServer(gameSrv), tlsConfig)
 
 	return &App{
 		GameServer: gameSrv,
 		GrpcServer: grpcSrv,
-		port:       port,
+		HTTPServer: httpSrv,
+		port:       cfg.GRPC.Port,
+	}, nil
+}
+
+// newNotifier returns the notifier of offline players, or nil when none is
+// configured.
+func newNotifier(cfg config.NotifierConfig) notifier.Notifier {
+	if cfg.WebhookURL == "" {
+		return nil
+	}
+	return notifier.NewWebhook(cfg.WebhookURL, cfg.WebhookSecret, cfg.WebhookTimeout)
+}
+
+// serverTLS returns the TLS config of the gRPC and HTTP servers, or nil when
+// TLS is not configured.
+func serverTLS(cfg config.TLSConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" && cfg.KeyFile == "" {
+		if cfg.ClientCAFile != "" {
+			return nil, errors.New("client CA is set but TLS is not, set the certificate and key too")
+		}
+		return nil, nil
+	}
+	minVersion, err := certs.ParseVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	reloader, err := certs.NewReloader(cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile, minVersion)
+	if err != nil {
+		return nil, err
 	}
+	return reloader.ServerConfig(), nil
 }
@@ -0,0 +1,94 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Position is a board waiting for a move. X always moves first, so the side
+// to move follows from the number of pieces.
+type Position struct {
+	Board     []string
+	Size      int
+	WinLength int
+}
+
+func (p Position) SideToMove() string {
+	var xCount, oCount int
+	for _, v := range p.Board {
+		switch v {
+		case "X":
+			xCount++
+		case "O":
+			oCount++
+		}
+	}
+	if xCount > oCount {
+		return "O"
+	}
+	return "X"
+}
+
+// Engine picks a move for the side to move. Implementations must return
+// when ctx is done, with the best move found so far if they have one.
+type Engine interface {
+	BestMove(ctx context.Context, pos Position) (int, error)
+}
+
+// Factory creates an engine for a board, or fails if the board is not supported.
+type Factory func(size, winLength int) (Engine, error)
+
+// Registry maps bot difficulty names to engine factories.
+type Registry struct {
+	factories map[string]Factory
+	mu        sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]Factory),
+	}
+}
+
+// NewDefaultRegistry returns a registry with the built-in "random" and "perfect" bots.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register("random", func(size, winLength int) (Engine, error) {
+		return Random{}, nil
+	})
+	r.Register("perfect", perfectFactory())
+	return r
+}
+
+func (r *Registry) Register(name string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.factories[name] = factory
+}
+
+func (r *Registry) New(name string, size, winLength int) (Engine, error) {
+	r.mu.RLock()
+	factory, exists := r.factories[name]
+	r.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("unknown bot %q", name)
+	}
+	return factory(size, winLength)
+}
+
+// Names returns the registered difficulties in alphabetical order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"TicTacToe/internal/solver"
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+)
+
+// Random plays a uniformly random empty cell.
+type Random struct{}
+
+func (Random) BestMove(ctx context.Context, pos Position) (int, error) {
+	var empty []int
+	for i, v := range pos.Board {
+		if v == "" {
+			empty = append(empty, i)
+		}
+	}
+	if len(empty) == 0 {
+		return 0, errors.New("no moves left")
+	}
+	return empty[rand.Intn(len(empty))], nil
+}
+
+// Perfect plays the solver's best move: the quickest win, otherwise a draw,
+// otherwise the slowest loss. It is only practical on 3x3 boards.
+type Perfect struct {
+	Solver *solver.Solver
+}
+
+func (p Perfect) BestMove(ctx context.Context, pos Position) (int, error) {
+	moves, err := p.Solver.Evaluate(pos.Board)
+	if err != nil {
+		return 0, err
+	}
+	if len(moves) == 0 {
+		return 0, errors.New("no moves left")
+	}
+
+	best := moves[0]
+	for _, m := range moves[1:] {
+		if solver.Better(m.Result, best.Result) {
+			best = m
+		}
+	}
+	return best.Position, nil
+}
+
+// perfectFactory shares one solver per board shape between all games.
+func perfectFactory() Factory {
+	var (
+		mu      sync.Mutex
+		solvers = make(map[[2]int]*solver.Solver)
+	)
+	return func(size, winLength int) (Engine, error) {
+		if size > 3 {
+			return nil, errors.New("perfect bot only plays on 3x3 boards")
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := [2]int{size, winLength}
+		s, exists := solvers[key]
+		if !exists {
+			s = solver.New(size, winLength)
+			solvers[key] = s
+		}
+		return Perfect{Solver: s}, nil
+	}
+}
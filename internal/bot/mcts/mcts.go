@@ -0,0 +1,257 @@
+// Package mcts implements a Monte Carlo Tree Search (UCT) engine for
+// k-in-a-row games on boards too large for exhaustive search.
+package mcts
+
+import (
+	"TicTacToe/internal/bot"
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultTimeBudget bounds the search when neither the config nor the
+// context of BestMove does.
+const DefaultTimeBudget = 3 * time.Second
+
+type Config struct {
+	// Playouts is the total number of simulations per move, shared by all workers.
+	Playouts int
+	// TimeBudget caps the thinking time per move. Without playouts or a
+	// context deadline, DefaultTimeBudget applies.
+	TimeBudget time.Duration
+	// Workers is the number of independent trees searched in parallel.
+	Workers int
+	// Exploration is the UCT exploration constant.
+	Exploration float64
+}
+
+// Engine searches with root parallelization: every worker grows its own tree
+// and the visit counts of the root moves are summed at the end.
+type Engine struct {
+	cfg Config
+}
+
+func New(cfg Config) *Engine {
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	if cfg.Exploration == 0 {
+		cfg.Exploration = math.Sqrt2
+	}
+	return &Engine{cfg: cfg}
+}
+
+// Factory registers the engine as a bot difficulty.
+func Factory(cfg Config) bot.Factory {
+	return func(size, winLength int) (bot.Engine, error) {
+		return New(cfg), nil
+	}
+}
+
+func (e *Engine) BestMove(ctx context.Context, pos bot.Position) (int, error) {
+	st, err := newState(pos)
+	if err != nil {
+		return 0, err
+	}
+	moves := st.candidates()
+	if len(moves) == 0 {
+		return 0, errors.New("no moves left")
+	}
+
+	// Take an immediate win or block an immediate loss without searching.
+	for _, player := range []int8{st.toMove, other(st.toMove)} {
+		for _, m := range moves {
+			if st.wins(m, player) {
+				return m, nil
+			}
+		}
+	}
+
+	budget := e.cfg.TimeBudget
+	if _, hasDeadline := ctx.Deadline(); budget <= 0 && e.cfg.Playouts <= 0 && !hasDeadline {
+		budget = DefaultTimeBudget
+	}
+	if budget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, budget)
+		defer cancel()
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		visits   = make(map[int]float64)
+		provenW  = -1
+		stop     atomic.Bool
+		playouts = e.cfg.Playouts / e.cfg.Workers
+	)
+	if e.cfg.Playouts > 0 && playouts == 0 {
+		playouts = 1
+	}
+	for w := 0; w < e.cfg.Workers; w++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			root := e.search(ctx, st, playouts, rand.New(rand.NewSource(seed)), &stop)
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, c := range root.children {
+				visits[c.move] += c.visits
+				if c.proven == provenWin {
+					provenW = c.move
+				}
+			}
+		}(time.Now().UnixNano() + int64(w))
+	}
+	wg.Wait()
+
+	if provenW >= 0 {
+		return provenW, nil
+	}
+	best, bestVisits := moves[0], -1.0
+	for m, v := range visits {
+		if v > bestVisits {
+			best, bestVisits = m, v
+		}
+	}
+	return best, nil
+}
+
+const (
+	provenLoss int8 = -1
+	provenWin  int8 = 1
+)
+
+type node struct {
+	move     int
+	player   int8 // player who made move
+	parent   *node
+	children []*node
+	untried  []int
+	visits   float64
+	score    float64 // wins for player, draws count as half
+	proven   int8    // game-theoretic value for player once known
+}
+
+func (e *Engine) search(ctx context.Context, root *state, playouts int, rnd *rand.Rand, stop *atomic.Bool) *node {
+	tree := &node{move: -1, player: other(root.toMove), untried: root.candidates()}
+	st := root.clone()
+
+	for i := 0; playouts <= 0 || i < playouts; i++ {
+		if i%64 == 0 && (ctx.Err() != nil || stop.Load()) {
+			break
+		}
+
+		st.copyFrom(root)
+		n := tree
+
+		// Selection
+		for len(n.untried) == 0 && len(n.children) > 0 && n.proven == 0 {
+			n = e.selectChild(n)
+			st.play(n.move, n.player)
+		}
+
+		// Expansion
+		winner := int8(-1)
+		if n.proven == 0 && len(n.untried) > 0 {
+			idx := rnd.Intn(len(n.untried))
+			move := n.untried[idx]
+			n.untried[idx] = n.untried[len(n.untried)-1]
+			n.untried = n.untried[:len(n.untried)-1]
+
+			player := other(n.player)
+			won := st.wins(move, player)
+			st.play(move, player)
+			child := &node{move: move, player: player, parent: n}
+			switch {
+			case won:
+				child.proven = provenWin
+				winner = player
+			case st.empty == 0:
+				winner = 0
+			default:
+				child.untried = st.candidates()
+			}
+			n.children = append(n.children, child)
+			n = child
+		} else if n.proven != 0 {
+			winner = n.player
+			if n.proven == provenLoss {
+				winner = other(n.player)
+			}
+		}
+
+		// Simulation
+		if winner < 0 {
+			winner = st.playout(other(n.player), n.move, rnd)
+		}
+
+		// Backpropagation
+		for b := n; b != nil; b = b.parent {
+			b.visits++
+			switch winner {
+			case b.player:
+				b.score++
+			case 0:
+				b.score += 0.5
+			}
+		}
+		prove(n)
+
+		if tree.proven == provenLoss {
+			// The side to move at the root has a forced win; no need to look further.
+			stop.Store(true)
+			break
+		}
+	}
+
+	return tree
+}
+
+// prove propagates solved values towards the root, MCTS-Solver style.
+func prove(n *node) {
+	for ; n != nil && n.parent != nil; n = n.parent {
+		p := n.parent
+		switch {
+		case n.proven == provenWin:
+			// The player to move at p has a winning reply, so p's player loses.
+			p.proven = provenLoss
+		case n.proven == provenLoss:
+			if len(p.untried) > 0 {
+				return
+			}
+			for _, c := range p.children {
+				if c.proven != provenLoss {
+					return
+				}
+			}
+			p.proven = provenWin
+		default:
+			return
+		}
+	}
+}
+
+func (e *Engine) selectChild(n *node) *node {
+	var best *node
+	bestValue := math.Inf(-1)
+	logN := math.Log(n.visits)
+	for _, c := range n.children {
+		if c.proven == provenWin {
+			return c
+		}
+		value := c.score/c.visits + e.cfg.Exploration*math.Sqrt(logN/c.visits)
+		if c.proven == provenLoss {
+			value = math.Inf(-1)
+		}
+		if best == nil || value > bestValue {
+			best, bestValue = c, value
+		}
+	}
+	return best
+}
@@ -0,0 +1,161 @@
+package mcts
+
+import (
+	"TicTacToe/internal/bot"
+	"errors"
+	"math/rand"
+)
+
+const (
+	x int8 = 1
+	o int8 = 2
+)
+
+func other(p int8) int8 {
+	return 3 - p
+}
+
+type state struct {
+	cells     []int8
+	size      int
+	winLength int
+	toMove    int8
+	empty     int
+}
+
+func newState(pos bot.Position) (*state, error) {
+	if len(pos.Board) != pos.Size*pos.Size {
+		return nil, errors.New("invalid board size")
+	}
+	st := &state{
+		cells:     make([]int8, len(pos.Board)),
+		size:      pos.Size,
+		winLength: pos.WinLength,
+		toMove:    x,
+	}
+	for i, v := range pos.Board {
+		switch v {
+		case "X":
+			st.cells[i] = x
+		case "O":
+			st.cells[i] = o
+		default:
+			st.empty++
+		}
+	}
+	if pos.SideToMove() == "O" {
+		st.toMove = o
+	}
+	return st, nil
+}
+
+func (s *state) clone() *state {
+	c := *s
+	c.cells = append([]int8(nil), s.cells...)
+	return &c
+}
+
+func (s *state) copyFrom(src *state) {
+	copy(s.cells, src.cells)
+	s.toMove = src.toMove
+	s.empty = src.empty
+}
+
+func (s *state) play(move int, player int8) {
+	s.cells[move] = player
+	s.toMove = other(player)
+	s.empty--
+}
+
+// wins reports whether player completes a line by playing move.
+func (s *state) wins(move int, player int8) bool {
+	r, c := move/s.size, move%s.size
+	for _, d := range [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}} {
+		count := 1
+		for _, sign := range []int{1, -1} {
+			for k := 1; k < s.winLength; k++ {
+				rr, cc := r+sign*k*d[0], c+sign*k*d[1]
+				if rr < 0 || rr >= s.size || cc < 0 || cc >= s.size || s.cells[rr*s.size+cc] != player {
+					break
+				}
+				count++
+			}
+		}
+		if count >= s.winLength {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates returns the empty cells near existing pieces. Far away cells are
+// almost never good moves on large boards and only dilute the search.
+func (s *state) candidates() []int {
+	if s.empty == len(s.cells) {
+		return []int{(s.size/2)*s.size + s.size/2}
+	}
+
+	const radius = 2
+	var moves []int
+	for i, v := range s.cells {
+		if v != 0 {
+			continue
+		}
+		r, c := i/s.size, i%s.size
+	near:
+		for dr := -radius; dr <= radius; dr++ {
+			for dc := -radius; dc <= radius; dc++ {
+				rr, cc := r+dr, c+dc
+				if rr >= 0 && rr < s.size && cc >= 0 && cc < s.size && s.cells[rr*s.size+cc] != 0 {
+					moves = append(moves, i)
+					break near
+				}
+			}
+		}
+	}
+	return moves
+}
+
+// playout plays random moves until the game ends and returns the winner, or 0 for a draw.
+// Moves are biased towards the neighbourhood of the previous move, which keeps
+// playouts on large boards closer to real play than uniformly random ones.
+func (s *state) playout(toMove int8, last int, rnd *rand.Rand) int8 {
+	empties := make([]int, 0, s.empty)
+	for i, v := range s.cells {
+		if v == 0 {
+			empties = append(empties, i)
+		}
+	}
+	rnd.Shuffle(len(empties), func(i, j int) {
+		empties[i], empties[j] = empties[j], empties[i]
+	})
+
+	player := toMove
+	next := 0
+	for s.empty > 0 {
+		m := -1
+		if last >= 0 {
+			for try := 0; try < 4 && m < 0; try++ {
+				r := last/s.size + rnd.Intn(5) - 2
+				c := last%s.size + rnd.Intn(5) - 2
+				if r >= 0 && r < s.size && c >= 0 && c < s.size && s.cells[r*s.size+c] == 0 {
+					m = r*s.size + c
+				}
+			}
+		}
+		for m < 0 {
+			if s.cells[empties[next]] == 0 {
+				m = empties[next]
+			}
+			next++
+		}
+
+		if s.wins(m, player) {
+			return player
+		}
+		s.play(m, player)
+		player = other(player)
+		last = m
+	}
+	return 0
+}
@@ -0,0 +1,85 @@
+// Package canon maps boards to a canonical form under the symmetries of their shape,
+// so that searches can treat rotated or reflected positions as one.
+//
+// Cells are bytes: 'X', 'O', or '.' for an empty cell, stored row by row.
+package canon
+
+// Symmetry maps every target cell to the source cell it is copied from.
+type Symmetry []int
+
+// Geometry describes a rectangular board and its symmetry group: the 8 rotations and
+// reflections of a square, or the 4 flips and half-turn of a non-square rectangle.
+type Geometry struct {
+	Rows int
+	Cols int
+
+	syms    []Symmetry
+	inverse []Symmetry
+}
+
+func NewGeometry(rows, cols int) *Geometry {
+	transforms := []func(r, c int) (int, int){
+		func(r, c int) (int, int) { return r, c },
+		func(r, c int) (int, int) { return rows - 1 - r, cols - 1 - c },
+		func(r, c int) (int, int) { return r, cols - 1 - c },
+		func(r, c int) (int, int) { return rows - 1 - r, c },
+	}
+	if rows == cols {
+		n := rows
+		transforms = append(transforms,
+			func(r, c int) (int, int) { return c, n - 1 - r },
+			func(r, c int) (int, int) { return n - 1 - c, r },
+			func(r, c int) (int, int) { return c, r },
+			func(r, c int) (int, int) { return n - 1 - c, n - 1 - r },
+		)
+	}
+
+	g := &Geometry{Rows: rows, Cols: cols}
+	for _, t := range transforms {
+		sym := make(Symmetry, rows*cols)
+		inv := make(Symmetry, rows*cols)
+		for r := 0; r < rows; r++ {
+			for c := 0; c < cols; c++ {
+				sr, sc := t(r, c)
+				sym[r*cols+c] = sr*cols + sc
+				inv[sr*cols+sc] = r*cols + c
+			}
+		}
+		g.syms = append(g.syms, sym)
+		g.inverse = append(g.inverse, inv)
+	}
+
+	return g
+}
+
+// Symmetries returns the symmetry group of the board, identity first.
+func (g *Geometry) Symmetries() []Symmetry {
+	return g.syms
+}
+
+// Apply returns the image of the board under a symmetry.
+func (s Symmetry) Apply(cells []byte) []byte {
+	out := make([]byte, len(cells))
+	for i, from := range s {
+		out[i] = cells[from]
+	}
+	return out
+}
+
+// Canonical returns the lexicographically smallest image of the board
+// and the index of the symmetry that produced it.
+func (g *Geometry) Canonical(cells []byte) ([]byte, int) {
+	best := cells
+	bestIdx := 0
+	buf := make([]byte, len(cells))
+	for i, sym := range g.syms[1:] {
+		for to, from := range sym {
+			buf[to] = cells[from]
+		}
+		if string(buf) < string(best) {
+			best = append([]byte(nil), buf...)
+			bestIdx = i + 1
+		}
+	}
+	return best, bestIdx
+}
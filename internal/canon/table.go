@@ -0,0 +1,45 @@
+package canon
+
+// Table is a fixed-size transposition table. Each key maps to one slot and a new
+// entry always replaces the old one, so memory stays bounded however deep the search.
+// A Table is not safe for concurrent use.
+type Table[V any] struct {
+	entries []entry[V]
+	mask    uint64
+	hits    uint64
+	misses  uint64
+}
+
+type entry[V any] struct {
+	key   uint64
+	value V
+	used  bool
+}
+
+// NewTable creates a table with 2^bits slots.
+func NewTable[V any](bits int) *Table[V] {
+	return &Table[V]{
+		entries: make([]entry[V], 1<<bits),
+		mask:    1<<bits - 1,
+	}
+}
+
+func (t *Table[V]) Get(key uint64) (V, bool) {
+	e := &t.entries[key&t.mask]
+	if e.used && e.key == key {
+		t.hits++
+		return e.value, true
+	}
+	t.misses++
+	var zero V
+	return zero, false
+}
+
+func (t *Table[V]) Put(key uint64, value V) {
+	t.entries[key&t.mask] = entry[V]{key: key, value: value, used: true}
+}
+
+// Stats returns the number of lookups that found and did not find an entry.
+func (t *Table[V]) Stats() (hits, misses uint64) {
+	return t.hits, t.misses
+}
@@ -0,0 +1,55 @@
+package canon
+
+import "math/rand"
+
+// Zobrist hashes boards for every symmetry at once. The canonical hash is the smallest
+// of them, so all images of a position share a key.
+type Zobrist struct {
+	geo  *Geometry
+	keys [][2]uint64
+}
+
+// Hashes holds one hash per symmetry of the geometry.
+type Hashes []uint64
+
+func NewZobrist(geo *Geometry, seed int64) *Zobrist {
+	rnd := rand.New(rand.NewSource(seed))
+	keys := make([][2]uint64, geo.Rows*geo.Cols)
+	for i := range keys {
+		keys[i] = [2]uint64{rnd.Uint64(), rnd.Uint64()}
+	}
+	return &Zobrist{geo: geo, keys: keys}
+}
+
+// Hash computes the hashes of a board from scratch.
+func (z *Zobrist) Hash(cells []byte) Hashes {
+	h := make(Hashes, len(z.geo.syms))
+	for cell, piece := range cells {
+		if piece == 'X' || piece == 'O' {
+			z.Toggle(h, cell, piece)
+		}
+	}
+	return h
+}
+
+// Toggle adds a piece to the hashes, or removes it if it is already there.
+func (z *Zobrist) Toggle(h Hashes, cell int, piece byte) {
+	idx := 0
+	if piece == 'O' {
+		idx = 1
+	}
+	for s := range h {
+		h[s] ^= z.keys[z.geo.inverse[s][cell]][idx]
+	}
+}
+
+// Canonical returns the key shared by all symmetric images of the board.
+func (h Hashes) Canonical() uint64 {
+	min := h[0]
+	for _, v := range h[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
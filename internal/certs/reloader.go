@@ -0,0 +1,177 @@
+// Package certs serves TLS certificates that are reloaded when their files
+// change, so certificates can be renewed without restarting the server.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Renewals usually write the certificate and the key separately, so reloads
+// wait for the files to settle.
+const reloadDelay = 500 * time.Millisecond
+
+// Reloader holds a certificate and an optional client CA loaded from files.
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+	minVersion   uint16
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+}
+
+// NewReloader loads the files and watches them for changes. With a client CA,
+// clients must present a certificate signed by it (mutual TLS).
+func NewReloader(certFile, keyFile, clientCAFile string, minVersion uint16) (*Reloader, error) {
+	r := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+		minVersion:   minVersion,
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	if err := r.watch(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ServerConfig returns a TLS config that always uses the latest files.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         r.minVersion,
+		GetConfigForClient: r.configForClient,
+	}
+}
+
+func (r *Reloader) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	config := &tls.Config{
+		MinVersion:   r.minVersion,
+		Certificates: []tls.Certificate{*r.cert},
+		// gRPC needs HTTP/2, browsers and WebSockets on the HTTP port may
+		// still use HTTP/1.1
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if r.clientCA != nil {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = r.clientCA
+	}
+	return config, nil
+}
+
+func (r *Reloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	var clientCA *x509.CertPool
+	if r.clientCAFile != "" {
+		clientCA, err = LoadCertPool(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to load client CA: %w", err)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.clientCA = &cert, clientCA
+	r.mu.Unlock()
+	return nil
+}
+
+// watch reloads the files when anything changes in their directories. Watching
+// the directories rather than the files survives files being replaced, like
+// Kubernetes does when it updates a secret.
+func (r *Reloader) watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch certificates: %w", err)
+	}
+	dirs := make(map[string]bool)
+	for _, file := range []string{r.certFile, r.keyFile, r.clientCAFile} {
+		if file != "" {
+			dirs[filepath.Dir(file)] = true
+		}
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+	}
+
+	go func() {
+		var timer *time.Timer
+		for {
+			select {
+			case _, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if timer == nil {
+					timer = time.AfterFunc(reloadDelay, r.reloadLogged)
+				} else {
+					timer.Reset(reloadDelay)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				slog.Error("Certificate watcher error", "error", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// reloadLogged keeps serving the previous certificate if the new files are
+// incomplete or invalid.
+func (r *Reloader) reloadLogged() {
+	if err := r.reload(); err != nil {
+		slog.Error("Failed to reload certificates, keeping the previous ones", "error", err)
+		return
+	}
+	slog.Info("Reloaded certificates", "cert", r.certFile)
+}
+
+// LoadCertPool reads the PEM certificates of a CA file.
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no certificates found in " + path)
+	}
+	return pool, nil
+}
+
+// ParseVersion converts a TLS version such as "1.2" to its crypto/tls
+// constant. An empty version means TLS 1.2.
+func ParseVersion(s string) (uint16, error) {
+	switch s {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("unsupported TLS version %q, use 1.2 or 1.3", s)
+}
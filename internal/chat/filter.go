@@ -0,0 +1,78 @@
+// Package chat moderates chat messages before they reach other players.
+package chat
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Filter checks a message before it is sent. It returns the text to send,
+// which may be censored, or an error to reject the message. The error is
+// shown to the sender.
+type Filter interface {
+	Filter(text string) (string, error)
+}
+
+// FilterFunc lets an ordinary function be used as a Filter.
+type FilterFunc func(text string) (string, error)
+
+func (f FilterFunc) Filter(text string) (string, error) {
+	return f(text)
+}
+
+// Chain runs filters one after another, each on the text of the previous one.
+func Chain(filters ...Filter) Filter {
+	return FilterFunc(func(text string) (string, error) {
+		for _, f := range filters {
+			var err error
+			if text, err = f.Filter(text); err != nil {
+				return "", err
+			}
+		}
+		return text, nil
+	})
+}
+
+// Blocklist replaces blocked words with asterisks. Words are matched whole
+// and regardless of case.
+type Blocklist struct {
+	words map[string]bool
+}
+
+func NewBlocklist(words []string) *Blocklist {
+	b := &Blocklist{words: make(map[string]bool, len(words))}
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			b.words[strings.ToLower(w)] = true
+		}
+	}
+	return b
+}
+
+func (b *Blocklist) Filter(text string) (string, error) {
+	if len(b.words) == 0 {
+		return text, nil
+	}
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		if b.words[strings.ToLower(string(runes[start:end]))] {
+			for i := start; i < end; i++ {
+				runes[i] = '*'
+			}
+		}
+		start = end
+	}
+	return string(runes), nil
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
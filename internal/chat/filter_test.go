@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBlocklist(t *testing.T) {
+	b := NewBlocklist([]string{"darn", " Heck ", "", "über"})
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"darn it", "**** it"},
+		{"DARN it", "**** it"},
+		{"Heck, darn!", "****, ****!"},
+		{"darned", "darned"},
+		{"undarn", "undarn"},
+		{"darn2", "darn2"},
+		{"heck-darn", "****-****"},
+		{"Über alles", "**** alles"},
+		{"überall", "überall"},
+		{"naïve darn", "naïve ****"},
+		{"darné", "darné"},
+		{"😀darn😀", "😀****😀"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		got, err := b.Filter(tt.text)
+		if err != nil || got != tt.want {
+			t.Errorf("Filter(%q) = %q, %v; want %q", tt.text, got, err, tt.want)
+		}
+	}
+}
+
+func TestEmptyBlocklist(t *testing.T) {
+	if got, _ := NewBlocklist(nil).Filter("darn it"); got != "darn it" {
+		t.Errorf("empty blocklist changed %q", got)
+	}
+}
+
+func TestChain(t *testing.T) {
+	errRejected := errors.New("rejected")
+	reject := FilterFunc(func(text string) (string, error) {
+		if text == "**** it" {
+			return "", errRejected
+		}
+		return text, nil
+	})
+	f := Chain(NewBlocklist([]string{"darn"}), reject)
+
+	if got, err := f.Filter("hello"); err != nil || got != "hello" {
+		t.Errorf("Filter(hello) = %q, %v", got, err)
+	}
+	// Each filter sees the text of the previous one
+	if _, err := f.Filter("darn it"); !errors.Is(err, errRejected) {
+		t.Errorf("Filter(darn it) = %v, want the error of the second filter", err)
+	}
+}
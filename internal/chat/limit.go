@@ -0,0 +1,74 @@
+package chat
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter allows each sender a burst of messages, then one more every
+// interval (a token bucket per sender).
+type Limiter struct {
+	burst    int
+	interval time.Duration
+	senders  map[string]*bucket
+	now      func() time.Time // Replaced in tests
+	mu       sync.Mutex
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// Buckets are pruned once this many senders are tracked.
+const pruneLimit = 1024
+
+func NewLimiter(burst int, interval time.Duration) *Limiter {
+	return &Limiter{
+		burst:    max(burst, 1),
+		interval: interval,
+		senders:  make(map[string]*bucket),
+		now:      time.Now,
+	}
+}
+
+// Allow reports whether a sender may send a message now, and counts it if so.
+// A limiter without an interval allows everything.
+func (l *Limiter) Allow(sender string) bool {
+	if l.interval <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if len(l.senders) >= pruneLimit {
+		l.prune(now)
+	}
+	b, ok := l.senders[sender]
+	if !ok {
+		b = &bucket{tokens: float64(l.burst), updated: now}
+		l.senders[sender] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.updated = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *Limiter) refill(b *bucket, now time.Time) float64 {
+	tokens := b.tokens + float64(now.Sub(b.updated))/float64(l.interval)
+	return min(tokens, float64(l.burst))
+}
+
+// prune forgets senders whose bucket is full again, as a new bucket is the same.
+func (l *Limiter) prune(now time.Time) {
+	for sender, b := range l.senders {
+		if l.refill(b, now) >= float64(l.burst) {
+			delete(l.senders, sender)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package chat
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// fakeTime returns a limiter clock and a function to move it forward.
+func fakeTime(l *Limiter) func(time.Duration) {
+	now := start
+	l.now = func() time.Time { return now }
+	return func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestLimiter(t *testing.T) {
+	type step struct {
+		after  time.Duration // Since the previous step
+		sender string
+		want   bool
+	}
+	tests := []struct {
+		name  string
+		burst int
+		steps []step
+	}{
+		{"burst", 3, []step{
+			{0, "a", true},
+			{0, "a", true},
+			{0, "a", true},
+			{0, "a", false},
+		}},
+		{"senders have their own bucket", 1, []step{
+			{0, "a", true},
+			{0, "a", false},
+			{0, "b", true},
+		}},
+		{"one more every interval", 2, []step{
+			{0, "a", true},
+			{0, "a", true},
+			{time.Second / 2, "a", false},
+			{time.Second / 2, "a", true},
+			{0, "a", false},
+		}},
+		{"refill stops at the burst", 2, []step{
+			{0, "a", true},
+			{time.Hour, "a", true},
+			{0, "a", true},
+			{0, "a", false},
+		}},
+		{"refused messages don't count", 1, []step{
+			{0, "a", true},
+			{time.Second / 2, "a", false},
+			{time.Second / 2, "a", true},
+		}},
+		{"burst of at least one", 0, []step{
+			{0, "a", true},
+			{0, "a", false},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLimiter(tt.burst, time.Second)
+			advance := fakeTime(l)
+			for i, s := range tt.steps {
+				advance(s.after)
+				if got := l.Allow(s.sender); got != s.want {
+					t.Errorf("step %d: Allow(%q) = %v, want %v", i+1, s.sender, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterWithoutInterval(t *testing.T) {
+	l := NewLimiter(1, 0)
+	for i := 0; i < 10; i++ {
+		if !l.Allow("a") {
+			t.Fatalf("message %d refused without an interval", i+1)
+		}
+	}
+}
+
+func TestLimiterPrunesFullBuckets(t *testing.T) {
+	l := NewLimiter(2, time.Second)
+	advance := fakeTime(l)
+
+	for i := 0; i < pruneLimit-1; i++ {
+		l.Allow(fmt.Sprint("idle", i))
+	}
+	l.Allow("busy")
+	l.Allow("busy")
+	// The idle senders have their burst back, the busy one not yet
+	advance(time.Second)
+	l.Allow("new")
+
+	if len(l.senders) != 2 {
+		t.Errorf("%d senders tracked after pruning, want busy and new", len(l.senders))
+	}
+	if !l.Allow("busy") || l.Allow("busy") {
+		t.Error("pruning changed the bucket of a sender who isn't full")
+	}
+}
@@ -0,0 +1,109 @@
+// Package clock lets code that waits for time to pass run on a fake clock.
+package clock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls f on its own goroutine once d has passed.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+type Timer interface {
+	// Stop prevents the call, and reports whether it was still pending.
+	Stop() bool
+}
+
+type realClock struct{}
+
+// Real returns the system clock.
+func Real() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// Fake is a clock that only moves when told to, for tests.
+type Fake struct {
+	now    time.Time
+	timers []*fakeTimer
+	mu     sync.Mutex
+}
+
+type fakeTimer struct {
+	clock *Fake
+	at    time.Time
+	f     func()
+}
+
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+func (c *Fake) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *Fake) AfterFunc(d time.Duration, f func()) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{clock: c, at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward, calling the functions that become due in
+// order. Unlike with the real clock, they are called before Advance returns.
+func (c *Fake) Advance(d time.Duration) {
+	c.mu.Lock()
+	end := c.now.Add(d)
+	for {
+		i := c.nextDue(end)
+		if i < 0 {
+			break
+		}
+		t := c.timers[i]
+		c.timers = slices.Delete(c.timers, i, i+1)
+		c.now = t.at
+		c.mu.Unlock()
+		t.f()
+		c.mu.Lock()
+	}
+	c.now = end
+	c.mu.Unlock()
+}
+
+// nextDue returns the index of the earliest timer due by end, or -1.
+func (c *Fake) nextDue(end time.Time) int {
+	next := -1
+	for i, t := range c.timers {
+		if !t.at.After(end) && (next < 0 || t.at.Before(c.timers[next].at)) {
+			next = i
+		}
+	}
+	return next
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	i := slices.Index(c.timers, t)
+	if i < 0 {
+		return false
+	}
+	c.timers = slices.Delete(c.timers, i, i+1)
+	return true
+}
@@ -7,13 +7,74 @@ import (
 )
 
 type Config struct {
-	Env  string `yaml:"env" env-default:"local"`
-	GRPC GRPCConfig
+	Env      string `yaml:"env" env-default:"local"`
+	GRPC     GRPCConfig
+	HTTP     HTTPConfig     `yaml:"http"`
+	Bot      BotConfig      `yaml:"bot"`
+	Chat     ChatConfig     `yaml:"chat"`
+	Notifier NotifierConfig `yaml:"notifier"`
 }
 
 type GRPCConfig struct {
 	Port    int           `yaml:"port"`
 	Timeout time.Duration `yaml:"timeout"`
+	TLS     TLSConfig     `yaml:"tls"`
+}
+
+// TLSConfig enables TLS on the gRPC and HTTP ports when a certificate is set.
+// The files are reloaded when they change.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// Clients must present a certificate signed by this CA when set (mutual TLS)
+	ClientCAFile string `yaml:"client_ca_file"`
+	MinVersion   string `yaml:"min_version" env-default:"1.2"`
+}
+
+// HTTPConfig configures the REST/JSON gateway to the gRPC service.
+type HTTPConfig struct {
+	Port int `yaml:"port" env-default:"17078"`
+}
+
+type BotConfig struct {
+	MoveTime time.Duration `yaml:"move_time" env-default:"5s"`
+	MCTS     MCTSConfig    `yaml:"mcts"`
+	// External engines launched as subprocesses, see internal/engine
+	Engines []EngineConfig `yaml:"engines"`
+}
+
+type MCTSConfig struct {
+	Playouts   int           `yaml:"playouts" env-default:"20000"`
+	TimeBudget time.Duration `yaml:"time_budget" env-default:"3s"`
+	Workers    int           `yaml:"workers" env-default:"4"`
+}
+
+type EngineConfig struct {
+	Name string   `yaml:"name"`
+	Path string   `yaml:"path"`
+	Args []string `yaml:"args"`
+}
+
+type ChatConfig struct {
+	MaxLength int `yaml:"max_length" env-default:"200"`
+	// Players can send Burst messages at once, then one every Interval
+	Burst    int           `yaml:"burst" env-default:"5"`
+	Interval time.Duration `yaml:"interval" env-default:"2s"`
+	// Words replaced with asterisks
+	Blocklist []string `yaml:"blocklist"`
+	// Reactions are limited separately from messages
+	ReactionBurst    int           `yaml:"reaction_burst" env-default:"3"`
+	ReactionInterval time.Duration `yaml:"reaction_interval" env-default:"3s"`
+}
+
+// NotifierConfig configures how players without a notification stream are
+// notified, such as of their turn in a correspondence game.
+type NotifierConfig struct {
+	// Notifications are posted to this URL when set
+	WebhookURL string `yaml:"webhook_url"`
+	// Signs the requests when set, see notifier.SignatureHeader
+	WebhookSecret  string        `yaml:"webhook_secret"`
+	WebhookTimeout time.Duration `yaml:"webhook_timeout" env-default:"10s"`
 }
 
 var (
@@ -0,0 +1,192 @@
+package engine
+
+import (
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Extra time an engine may take over the announced wtime
+const timeTolerance = 150 * time.Millisecond
+
+// CheckResult is the outcome of one conformance check.
+type CheckResult struct {
+	Name string
+	Err  error
+}
+
+type check struct {
+	name string
+	run  func(path string, args []string) error
+}
+
+var checks = []check{
+	{"handshake", checkHandshake},
+	{"legal opening move", checkOpening},
+	{"takes immediate win", checkWin},
+	{"blocks immediate loss", checkBlock},
+	{"plays full game", checkFullGame},
+	{"respects wtime", checkMoveTime},
+	{"answers stop", checkStop},
+	{"wins on 5x5", checkFiveByFive},
+	{"quits", checkQuit},
+}
+
+// CheckConformance runs the protocol conformance checks against an engine
+// executable. Every check starts a fresh engine process.
+func CheckConformance(path string, args ...string) []CheckResult {
+	results := make([]CheckResult, 0, len(checks))
+	for _, c := range checks {
+		results = append(results, CheckResult{Name: c.name, Err: c.run(path, args)})
+	}
+	return results
+}
+
+func startGame(path string, args []string, size, winLength int) (*Process, error) {
+	p, err := Start(path, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.NewGame(size, winLength); err != nil {
+		p.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+func checkHandshake(path string, args []string) error {
+	p, err := Start(path, args...)
+	if err != nil {
+		return err
+	}
+	return p.Close()
+}
+
+// expectMove asks for a move in the given position and checks it is one of want.
+func expectMove(path string, args []string, board string, want ...int) error {
+	cells, size, err := ParseBoard(board)
+	if err != nil {
+		return err
+	}
+	p, err := startGame(path, args, size, size)
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	move, err := p.BestMove(ctx, bot.Position{Board: cells, Size: size, WinLength: size})
+	if err != nil {
+		return err
+	}
+	for _, w := range want {
+		if move == w {
+			return nil
+		}
+	}
+	return fmt.Errorf("engine played %d in %s, expected one of %v", move, board, want)
+}
+
+func checkOpening(path string, args []string) error {
+	return expectMove(path, args, ".../.../...", 0, 1, 2, 3, 4, 5, 6, 7, 8)
+}
+
+func checkWin(path string, args []string) error {
+	return expectMove(path, args, "xx./oo./...", 2)
+}
+
+func checkBlock(path string, args []string) error {
+	return expectMove(path, args, "x.x/.o./...", 1)
+}
+
+// checkFullGame lets the engine play both sides of a 3x3 game, which with
+// correct play ends in a draw.
+func checkFullGame(path string, args []string) error {
+	p, err := startGame(path, args, 3, 3)
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	pos := bot.Position{Board: make([]string, 9), Size: 3, WinLength: 3}
+	for !utils.IsBoardFull(pos.Board) && utils.CheckWin(pos.Board, 3, 3) == "" {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		move, err := p.BestMove(ctx, pos)
+		cancel()
+		if err != nil {
+			return err
+		}
+		pos.Board[move] = pos.SideToMove()
+	}
+	if winner := utils.CheckWin(pos.Board, 3, 3); winner != "" {
+		return fmt.Errorf("self-play on 3x3 was won by %s, expected a draw", winner)
+	}
+	return nil
+}
+
+func checkMoveTime(path string, args []string) error {
+	p, err := startGame(path, args, 15, 5)
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	const wtime = 500 * time.Millisecond
+	pos := bot.Position{Board: make([]string, 15*15), Size: 15, WinLength: 5}
+	pos.Board[7*15+7] = "X"
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), wtime)
+	defer cancel()
+	if _, err := p.BestMove(ctx, pos); err != nil {
+		return err
+	}
+	if elapsed := time.Since(start); elapsed > wtime+timeTolerance {
+		return fmt.Errorf("engine took %v for a %v move", elapsed.Round(time.Millisecond), wtime)
+	}
+	return nil
+}
+
+// checkStop announces a long think and interrupts it with "stop".
+func checkStop(path string, args []string) error {
+	p, err := startGame(path, args, 15, 5)
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	pos := bot.Position{Board: make([]string, 15*15), Size: 15, WinLength: 5}
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+
+	_, err = p.BestMove(ctx, pos)
+	return err
+}
+
+func checkFiveByFive(path string, args []string) error {
+	return expectMove(path, args, "xxxx./oooo./...../...../.....", 4)
+}
+
+func checkQuit(path string, args []string) error {
+	p, err := Start(path, args...)
+	if err != nil {
+		return err
+	}
+	if err := p.send("quit"); err != nil {
+		return err
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- p.cmd.Wait() }()
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(stopGrace):
+		p.cmd.Process.Kill()
+		return errors.New("engine did not exit after quit")
+	}
+}
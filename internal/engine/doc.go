@@ -0,0 +1,20 @@
+// Package engine runs third-party bots as subprocesses speaking a line-based
+// protocol on stdin and stdout, in the spirit of UCI and GTP.
+//
+// Every command and reply is a single line of space separated words. The server
+// sends commands, the engine answers:
+//
+//	tictactoe 1                 start of session, protocol version 1
+//	    id name <text>          optional, any number of id lines
+//	    ready                   engine is ready for commands
+//	newgame <size> <winlength>  a new game on a size x size board
+//	position <board>            the current board, rows top to bottom separated by
+//	                            "/", one character per cell: "x", "o" or "." for empty
+//	go wtime <ms>               choose a move for the side to move within ms milliseconds
+//	    bestmove <cell>         the chosen cell in algebraic notation, such as "b2"
+//	stop                        answer the pending go immediately
+//	quit                        exit the process
+//
+// X always moves first, so the side to move follows from the board. Lines
+// starting with "info" are diagnostics and ignored, as are unknown lines.
+package engine
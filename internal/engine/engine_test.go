@@ -0,0 +1,26 @@
+package engine
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestConformance builds the reference engine and runs the conformance
+// checks against it.
+func TestConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds and runs the reference engine")
+	}
+	path := filepath.Join(t.TempDir(), "engine")
+	build := exec.Command("go", "build", "-o", path, "TicTacToe/cmd/engine")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("build reference engine: %v\n%s", err, out)
+	}
+
+	for _, r := range CheckConformance(path) {
+		if r.Err != nil {
+			t.Errorf("%s: %v", r.Name, r.Err)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package engine
+
+import (
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/game"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	handshakeTimeout = 5 * time.Second
+	// Time an engine gets to answer "stop" before the move is given up.
+	stopGrace = time.Second
+	// Think time announced when the caller sets no deadline.
+	defaultMoveTime = 5 * time.Second
+)
+
+// Process is a running engine subprocess. It implements bot.Engine for a single game at a time.
+type Process struct {
+	Name string
+
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	lines chan string
+	size  int
+	mu    sync.Mutex
+}
+
+// Start launches the engine and performs the handshake.
+func Start(path string, args ...string) (*Process, error) {
+	cmd := exec.Command(path, args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open engine stdin: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open engine stdout: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start engine: %w", err)
+	}
+
+	p := &Process{
+		Name:  path,
+		cmd:   cmd,
+		stdin: stdin,
+		lines: make(chan string, 16),
+	}
+	go p.readLines(stdout)
+
+	if err := p.handshake(); err != nil {
+		p.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Process) readLines(r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "info") {
+			continue
+		}
+		p.lines <- line
+	}
+	close(p.lines)
+}
+
+func (p *Process) handshake() error {
+	if err := p.send("tictactoe 1"); err != nil {
+		return err
+	}
+
+	timeout := time.After(handshakeTimeout)
+	for {
+		select {
+		case line, ok := <-p.lines:
+			if !ok {
+				return errors.New("engine exited during handshake")
+			}
+			if name, found := strings.CutPrefix(line, "id name "); found {
+				p.Name = name
+			}
+			if line == "ready" {
+				return nil
+			}
+		case <-timeout:
+			return errors.New("engine did not answer the handshake")
+		}
+	}
+}
+
+func (p *Process) send(format string, args ...any) error {
+	_, err := fmt.Fprintf(p.stdin, format+"\n", args...)
+	if err != nil {
+		return fmt.Errorf("failed to write to engine: %w", err)
+	}
+	return nil
+}
+
+// drain drops replies left over from an earlier move that was given up.
+func (p *Process) drain() {
+	for {
+		select {
+		case _, ok := <-p.lines:
+			if !ok {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+// NewGame tells the engine about the board of the next game.
+func (p *Process) NewGame(size, winLength int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.size = size
+	return p.send("newgame %d %d", size, winLength)
+}
+
+func (p *Process) BestMove(ctx context.Context, pos bot.Position) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pos.Size != p.size {
+		return 0, errors.New("position does not match the engine's game")
+	}
+	p.drain()
+
+	moveTime := defaultMoveTime
+	if deadline, ok := ctx.Deadline(); ok {
+		moveTime = time.Until(deadline)
+	}
+	if err := p.send("position %s", FormatBoard(pos.Board, pos.Size)); err != nil {
+		return 0, err
+	}
+	if err := p.send("go wtime %d", moveTime.Milliseconds()); err != nil {
+		return 0, err
+	}
+
+	var grace <-chan time.Time
+	done := ctx.Done()
+	for {
+		select {
+		case line, ok := <-p.lines:
+			if !ok {
+				return 0, errors.New("engine exited")
+			}
+			cell, found := strings.CutPrefix(line, "bestmove ")
+			if !found {
+				continue
+			}
+			move, err := game.ParseCell(cell, pos.Size)
+			if err != nil {
+				return 0, err
+			}
+			if pos.Board[move] != "" {
+				return 0, fmt.Errorf("engine played occupied cell %s", cell)
+			}
+			return move, nil
+		case <-done:
+			// Ask for the best move so far and give the engine a moment to answer.
+			done = nil
+			if err := p.send("stop"); err != nil {
+				return 0, err
+			}
+			grace = time.After(stopGrace)
+		case <-grace:
+			return 0, errors.New("engine did not answer in time")
+		}
+	}
+}
+
+// Close asks the engine to quit and kills it if it does not.
+func (p *Process) Close() error {
+	// Wait for a move in progress, which ends by its deadline
+	p.mu.Lock()
+	_ = p.send("quit")
+	p.stdin.Close()
+	p.mu.Unlock()
+
+	exited := make(chan error, 1)
+	go func() { exited <- p.cmd.Wait() }()
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(stopGrace):
+		return p.cmd.Process.Kill()
+	}
+}
+
+// Factory registers an engine executable as a bot difficulty.
+// Every game gets its own engine process.
+func Factory(path string, args ...string) bot.Factory {
+	return func(size, winLength int) (bot.Engine, error) {
+		p, err := Start(path, args...)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.NewGame(size, winLength); err != nil {
+			p.Close()
+			return nil, err
+		}
+		return p, nil
+	}
+}
+
+// FormatBoard writes a board in the protocol's position format.
+func FormatBoard(board []string, size int) string {
+	var b strings.Builder
+	for i, v := range board {
+		if i > 0 && i%size == 0 {
+			b.WriteByte('/')
+		}
+		switch v {
+		case "X":
+			b.WriteByte('x')
+		case "O":
+			b.WriteByte('o')
+		default:
+			b.WriteByte('.')
+		}
+	}
+	return b.String()
+}
+
+// ParseBoard reads a board in the protocol's position format.
+func ParseBoard(s string) ([]string, int, error) {
+	rows := strings.Split(s, "/")
+	size := len(rows)
+	board := make([]string, 0, size*size)
+	for _, row := range rows {
+		if len(row) != size {
+			return nil, 0, fmt.Errorf("invalid board %q", s)
+		}
+		for _, c := range row {
+			switch c {
+			case 'x':
+				board = append(board, "X")
+			case 'o':
+				board = append(board, "O")
+			case '.':
+				board = append(board, "")
+			default:
+				return nil, 0, fmt.Errorf("invalid board %q", s)
+			}
+		}
+	}
+	return board, size, nil
+}
@@ -0,0 +1,52 @@
+package game
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"time"
+)
+
+// Challenge invites a known player to a game. Accepting it creates the game
+// with the challenger as X and the challenged player as O.
+type Challenge struct {
+	ID        string
+	From      *Player
+	To        *Player
+	Settings  Settings
+	GameID    string // Set once the challenge is accepted
+	ExpiresAt time.Time
+}
+
+func ChallengeToProto(c *Challenge) *tictactoev1.Challenge {
+	return &tictactoev1.Challenge{
+		ChallengeId: c.ID,
+		From:        PlayerToProto(c.From),
+		To:          PlayerToProto(c.To),
+		Settings:    SettingsToProto(c.Settings),
+		GameId:      c.GameID,
+		ExpiresAt:   c.ExpiresAt.Unix(),
+	}
+}
+
+// SettingsToProto returns the settings without the password.
+func SettingsToProto(s Settings) *tictactoev1.CreateGameRequest {
+	return &tictactoev1.CreateGameRequest{
+		AllowHints:    s.AllowHints,
+		BoardSize:     int32(s.Size),
+		WinLength:     int32(s.WinLength),
+		Bot:           s.Bot,
+		StartPosition: s.StartPosition,
+		DaysPerMove:   int32(s.DaysPerMove),
+	}
+}
+
+func SettingsFromProto(req *tictactoev1.CreateGameRequest) Settings {
+	return Settings{
+		Password:      req.GetPassword(),
+		AllowHints:    req.GetAllowHints(),
+		Size:          int(req.GetBoardSize()),
+		WinLength:     int(req.GetWinLength()),
+		Bot:           req.GetBot(),
+		StartPosition: req.GetStartPosition(),
+		DaysPerMove:   int(req.GetDaysPerMove()),
+	}
+}
@@ -0,0 +1,26 @@
+package game
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"time"
+)
+
+// ChatMessage is a message in the chat of a game.
+type ChatMessage struct {
+	ID      string
+	From    *Player
+	Channel tictactoev1.ChatChannel
+	Text    string
+	SentAt  time.Time
+}
+
+func ChatMessageToProto(gameID string, m *ChatMessage) *tictactoev1.ChatMessage {
+	return &tictactoev1.ChatMessage{
+		MessageId: m.ID,
+		GameId:    gameID,
+		From:      PlayerToProto(m.From),
+		Channel:   m.Channel,
+		Text:      m.Text,
+		SentAt:    m.SentAt.Unix(),
+	}
+}
@@ -0,0 +1,25 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// CellName returns the algebraic name of a cell: a column letter from "a" and
+// a row number from 1, counted from the top left corner. Cell 4 of a 3x3 board is "b2".
+func CellName(position, size int) string {
+	return fmt.Sprintf("%c%d", 'a'+position%size, position/size+1)
+}
+
+// ParseCell converts an algebraic cell name back to a board position.
+func ParseCell(name string, size int) (int, error) {
+	if len(name) < 2 {
+		return 0, fmt.Errorf("invalid cell %q", name)
+	}
+	col := int(name[0] - 'a')
+	row, err := strconv.Atoi(name[1:])
+	if err != nil || col < 0 || col >= size || row < 1 || row > size {
+		return 0, fmt.Errorf("invalid cell %q", name)
+	}
+	return (row-1)*size + col, nil
+}
@@ -2,6 +2,7 @@ package game
 
 import (
 	tictactoev1 "TicTacToe/api/tictactoe"
+	"time"
 )
 
 type Player struct {
@@ -9,6 +10,19 @@ type Player struct {
 	Name string
 }
 
+// Settings are chosen by the creator of a game.
+type Settings struct {
+	Password   string // Empty for a public game
+	AllowHints bool
+	Size       int
+	WinLength  int
+	Bot        string
+	// StartPosition is an optional custom starting position in position notation.
+	StartPosition string
+	// DaysPerMove makes a correspondence game, 0 for a live game.
+	DaysPerMove int
+}
+
 type Game struct {
 	ID            string
 	PlayerX       *Player
@@ -17,10 +31,27 @@ type Game struct {
 	CurrentPlayer *Player
 	Status        tictactoev1.GameStatus
 	Event         tictactoev1.GameEvent
-	Password      string
+	PasswordHash  *PasswordHash // Nil for a public game
 	Updates       chan *tictactoev1.GameData
 	Players       map[string]chan *tictactoev1.GameData
 	Winner        string
+	AllowHints    bool
+	Moves         []int32
+	Size          int
+	WinLength     int
+	CreatedAt     time.Time
+	StartPosition string         // Position notation of the starting board, empty for an empty board
+	Version       int64          // Number of published updates, lets clients resume after a reconnect
+	Chat          []*ChatMessage // Recent chat messages, oldest first
+	TournamentID  string         // Set for the games of a tournament
+	DaysPerMove   int            // Set for a correspondence game
+	MoveDeadline  time.Time      // When the current player loses on time in a correspondence game
+}
+
+// Correspondence reports whether players have days rather than a sitting
+// to finish the game.
+func (g *Game) Correspondence() bool {
+	return g.DaysPerMove > 0
 }
 
 func PlayerToProto(p *Player) *tictactoev1.PlayerData {
@@ -52,7 +83,24 @@ func GameToProto(g *Game) *tictactoev1.GameData {
 		CurrentPlayer: PlayerToProto(g.CurrentPlayer),
 		Status:        g.Status,
 		Event:         g.Event,
-		Password:      g.Password,
+		HasPassword:   g.PasswordHash != nil,
+		TournamentId:  g.TournamentID,
 		Winner:        g.Winner,
+		AllowHints:    g.AllowHints,
+		Moves:         g.Moves,
+		BoardSize:     int32(g.Size),
+		WinLength:     int32(g.WinLength),
+		StartPosition: g.StartPosition,
+		Version:       g.Version,
+		DaysPerMove:   int32(g.DaysPerMove),
+		MoveDeadline:  unixTime(g.MoveDeadline),
+	}
+}
+
+// unixTime converts a time to Unix seconds, with 0 for the zero time.
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
 	}
+	return t.Unix()
 }
@@ -0,0 +1,38 @@
+package game
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+const (
+	passwordSaltSize   = 16
+	passwordIterations = 100_000
+)
+
+// PasswordHash is a salted PBKDF2-HMAC-SHA256 hash of a game password.
+type PasswordHash struct {
+	Salt []byte
+	Hash []byte
+}
+
+// HashPassword hashes a password with a new random salt.
+func HashPassword(password string) (*PasswordHash, error) {
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	hash, err := pbkdf2.Key(sha256.New, password, salt, passwordIterations, sha256.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &PasswordHash{Salt: salt, Hash: hash}, nil
+}
+
+// Matches reports whether password hashes to h, in constant time.
+func (h *PasswordHash) Matches(password string) bool {
+	hash, err := pbkdf2.Key(sha256.New, password, h.Salt, passwordIterations, len(h.Hash))
+	return err == nil && subtle.ConstantTimeCompare(hash, h.Hash) == 1
+}
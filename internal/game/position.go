@@ -0,0 +1,167 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxBoardSize is the largest board side the server plays and reads
+// positions of.
+const MaxBoardSize = 19
+
+// Position is a board with everything needed to continue the game from it.
+//
+// Its notation is a single line in the spirit of FEN, for example
+//
+//	3/1x1/2o x 3 -
+//
+// The first field lists the cells row by row from the top, rows separated by
+// "/", with "x" and "o" for pieces and a number for a run of empty cells. The
+// board is square. Then follow the side to move, "x" or "o", the number of
+// pieces in a row needed to win and variant state, such as the active
+// sub-board of ultimate tic-tac-toe, or "-" when the variant has none.
+type Position struct {
+	Board     []string
+	Size      int
+	WinLength int
+	ToMove    string
+	State     string
+}
+
+// NewPosition describes a board of a running game. X always moves first, so
+// the side to move follows from the pieces.
+func NewPosition(board []string, size, winLength int) Position {
+	toMove := "X"
+	if countPieces(board, "X") > countPieces(board, "O") {
+		toMove = "O"
+	}
+	return Position{Board: board, Size: size, WinLength: winLength, ToMove: toMove, State: "-"}
+}
+
+// FormatPosition writes a board of a running game in position notation.
+func FormatPosition(board []string, size, winLength int) string {
+	return NewPosition(board, size, winLength).String()
+}
+
+func (p Position) String() string {
+	var b strings.Builder
+	for row := 0; row < p.Size; row++ {
+		if row > 0 {
+			b.WriteByte('/')
+		}
+		empty := 0
+		for _, cell := range p.Board[row*p.Size : (row+1)*p.Size] {
+			if cell == "" {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				b.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			b.WriteString(strings.ToLower(cell))
+		}
+		if empty > 0 {
+			b.WriteString(strconv.Itoa(empty))
+		}
+	}
+
+	state := p.State
+	if state == "" {
+		state = "-"
+	}
+	fmt.Fprintf(&b, " %s %d %s", strings.ToLower(p.ToMove), p.WinLength, state)
+	return b.String()
+}
+
+// ParsePosition reads a position in position notation. The side to move must
+// agree with the pieces on the board, as X always moves first.
+func ParsePosition(s string) (Position, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 4 {
+		return Position{}, fmt.Errorf("invalid position %q: expected 4 fields", s)
+	}
+
+	rows := strings.Split(fields[0], "/")
+	if len(rows) > MaxBoardSize {
+		return Position{}, fmt.Errorf("invalid position %q: more than %d rows", s, MaxBoardSize)
+	}
+	p := Position{Size: len(rows), State: fields[3]}
+	for i, row := range rows {
+		cells, err := parseRow(row, p.Size)
+		if err != nil {
+			return Position{}, fmt.Errorf("invalid position %q: row %d: %w", s, i+1, err)
+		}
+		if len(cells) != p.Size {
+			return Position{}, fmt.Errorf("invalid position %q: row %d has %d cells, expected %d", s, i+1, len(cells), p.Size)
+		}
+		p.Board = append(p.Board, cells...)
+	}
+
+	switch fields[1] {
+	case "x":
+		p.ToMove = "X"
+	case "o":
+		p.ToMove = "O"
+	default:
+		return Position{}, fmt.Errorf("invalid position %q: side to move must be x or o", s)
+	}
+	if NewPosition(p.Board, p.Size, 0).ToMove != p.ToMove ||
+		countPieces(p.Board, "X")-countPieces(p.Board, "O") > 1 ||
+		countPieces(p.Board, "O") > countPieces(p.Board, "X") {
+		return Position{}, fmt.Errorf("invalid position %q: piece counts do not match the side to move", s)
+	}
+
+	winLength, err := strconv.Atoi(fields[2])
+	if err != nil || winLength < 1 || winLength > p.Size {
+		return Position{}, fmt.Errorf("invalid position %q: invalid win length", s)
+	}
+	p.WinLength = winLength
+
+	return p, nil
+}
+
+// parseRow reads the cells of a row, which may not have more than size.
+func parseRow(row string, size int) ([]string, error) {
+	var cells []string
+	for i := 0; i < len(row); {
+		if len(cells) >= size {
+			return nil, fmt.Errorf("more than %d cells", size)
+		}
+		switch c := row[i]; {
+		case c == 'x':
+			cells = append(cells, "X")
+			i++
+		case c == 'o':
+			cells = append(cells, "O")
+			i++
+		case c >= '1' && c <= '9':
+			j := i + 1
+			for j < len(row) && row[j] >= '0' && row[j] <= '9' {
+				j++
+			}
+			// Checked before allocating, as the run may be of any length
+			n, err := strconv.Atoi(row[i:j])
+			if err != nil || n > size-len(cells) {
+				return nil, fmt.Errorf("more than %d cells", size)
+			}
+			cells = append(cells, make([]string, n)...)
+			i = j
+		default:
+			return nil, errors.New("unexpected character " + strconv.QuoteRune(rune(c)))
+		}
+	}
+	return cells, nil
+}
+
+func countPieces(board []string, piece string) int {
+	n := 0
+	for _, cell := range board {
+		if cell == piece {
+			n++
+		}
+	}
+	return n
+}
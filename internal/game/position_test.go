@@ -0,0 +1,51 @@
+package game
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParsePosition(t *testing.T) {
+	pos, err := ParsePosition("3/1x1/2o x 3 -")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"", "", "", "", "X", "", "", "", "O"}
+	if pos.Size != 3 || pos.WinLength != 3 || pos.ToMove != "X" || !slices.Equal(pos.Board, want) {
+		t.Errorf("parsed %+v", pos)
+	}
+	if got := pos.String(); got != "3/1x1/2o x 3 -" {
+		t.Errorf("String() = %q, want the notation it was parsed from", got)
+	}
+}
+
+func TestParseInvalidPosition(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+	}{
+		{"missing fields", "3/3/3 x 3"},
+		{"oversized run", "99999999999999999999/3/3 x 3 -"},
+		{"run past the row", "4/3/3 x 3 -"},
+		{"row too long", "xox1/3/3 o 3 -"},
+		{"row too short", "2/3/3 x 3 -"},
+		{"piece after a full run", "3x/3/3 o 3 -"},
+		{"too many rows", strings.Repeat("1/", MaxBoardSize) + "1 x 1 -"},
+		{"unknown piece", "3/1y1/3 x 3 -"},
+		{"unknown side to move", "3/3/3 y 3 -"},
+		{"o moves first", "3/3/3 o 3 -"},
+		{"x moves twice", "3/1x1/3 x 3 -"},
+		{"two pieces ahead", "xx1/3/3 o 3 -"},
+		{"more o than x", "o2/3/3 x 3 -"},
+		{"win length too long", "3/3/3 x 4 -"},
+		{"win length zero", "3/3/3 x 0 -"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if pos, err := ParsePosition(tt.position); err == nil {
+				t.Errorf("ParsePosition(%q) = %+v, want an error", tt.position, pos)
+			}
+		})
+	}
+}
@@ -4,11 +4,15 @@ import (
 	tictactoev1 "TicTacToe/api/tictactoe"
 	"TicTacToe/internal/game"
 	"TicTacToe/internal/server/gameserver"
+	"TicTacToe/internal/solver"
+	"TicTacToe/internal/tournament"
 	"context"
+	"errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"time"
 )
 
 type serverAPI struct {
@@ -16,10 +20,16 @@ type serverAPI struct {
 	gameServer *gameserver.GameServer
 }
 
-func Register(gRPC *grpc.Server, gameSrv *gameserver.GameServer) {
-	tictactoev1.RegisterGameServiceServer(gRPC, &serverAPI{
+// NewServer returns the GameService implementation, for serving it over
+// other transports than gRPC.
+func NewServer(gameSrv *gameserver.GameServer) tictactoev1.GameServiceServer {
+	return &serverAPI{
 		gameServer: gameSrv,
-	})
+	}
+}
+
+func Register(gRPC *grpc.Server, gameSrv *gameserver.GameServer) {
+	tictactoev1.RegisterGameServiceServer(gRPC, NewServer(gameSrv))
 }
 
 func (s *serverAPI) Login(ctx context.Context, req *tictactoev1.LoginRequest) (*tictactoev1.PlayerData, error) {
@@ -39,7 +49,10 @@ func (s *serverAPI) CreateGame(ctx context.Context, req *tictactoev1.CreateGameR
 	if !ok {
 		return nil, status.Error(codes.Internal, "auth error")
 	}
-	gameData, err := s.gameServer.CreateGame(ctx, player, req.GetPassword())
+	gameData, err := s.gameServer.CreateGame(ctx, player, game.SettingsFromProto(req))
+	if errors.Is(err, gameserver.ErrInvalidDaysPerMove) || errors.Is(err, gameserver.ErrCorrespondenceBot) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -54,12 +67,17 @@ func (s *serverAPI) JoinGame(ctx context.Context, req *tictactoev1.JoinGameReque
 		return nil, status.Error(codes.Internal, "auth error")
 	}
 	gameData, err := s.gameServer.JoinGame(ctx, req.GetGameId(), player, req.GetPassword())
+	if errors.Is(err, gameserver.ErrIncorrectPassword) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, gameserver.ErrTooManyAttempts) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	protoGame := game.GameToProto(gameData)
-	gameData.Updates <- protoGame
 
 	return protoGame, nil
 }
@@ -88,7 +106,6 @@ func (s *serverAPI) MakeMove(ctx context.Context, req *tictactoev1.MoveRequest)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	protoGame := game.GameToProto(gameData)
-	gameData.Updates <- protoGame
 
 	return protoGame, nil
 }
@@ -121,3 +138,515 @@ func (s *serverAPI) GetGameState(req *tictactoev1.GameRequest, stream tictactoev
 		}
 	}
 }
+
+func (s *serverAPI) AnalyzePosition(ctx context.Context, req *tictactoev1.AnalyzePositionRequest) (*tictactoev1.PositionAnalysis, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "player not found in context")
+	}
+
+	var (
+		moves []solver.Move
+		err   error
+	)
+	if req.GetGameId() != "" {
+		moves, err = s.gameServer.AnalyzeGame(ctx, req.GetGameId(), player.ID)
+	} else if req.GetPosition() != "" {
+		moves, err = s.gameServer.AnalyzePosition(ctx, player.ID, req.GetPosition())
+	} else {
+		moves, err = s.gameServer.AnalyzeBoard(ctx, player.ID, req.GetBoard())
+	}
+	if errors.Is(err, gameserver.ErrHintsDisabled) || errors.Is(err, gameserver.ErrNotInGame) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cells := make([]*tictactoev1.CellEvaluation, len(moves))
+	for i, m := range moves {
+		cells[i] = &tictactoev1.CellEvaluation{
+			Position: int32(m.Position),
+			Outcome:  outcomeToProto(m.Result.Outcome),
+			Distance: int32(m.Result.Distance),
+		}
+	}
+
+	return &tictactoev1.PositionAnalysis{Cells: cells}, nil
+}
+
+func (s *serverAPI) ListBots(ctx context.Context, req *tictactoev1.ListBotsRequest) (*tictactoev1.BotList, error) {
+	return &tictactoev1.BotList{Names: s.gameServer.BotNames()}, nil
+}
+
+func (s *serverAPI) GetPuzzle(ctx context.Context, req *tictactoev1.GetPuzzleRequest) (*tictactoev1.Puzzle, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "player not found in context")
+	}
+
+	p, rating, err := s.gameServer.NextPuzzle(ctx, player)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &tictactoev1.Puzzle{
+		PuzzleId:     p.ID,
+		Board:        p.Board,
+		BoardSize:    int32(p.Size),
+		WinLength:    int32(p.WinLength),
+		MovesToWin:   int32(p.MovesToWin),
+		Rating:       int32(p.Rating),
+		PlayerRating: int32(rating),
+	}, nil
+}
+
+func (s *serverAPI) SubmitPuzzleSolution(ctx context.Context, req *tictactoev1.SubmitPuzzleSolutionRequest) (*tictactoev1.PuzzleResult, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "player not found in context")
+	}
+
+	attempt, err := s.gameServer.SubmitPuzzleSolution(ctx, player, req.GetPuzzleId(), int(req.GetPosition()))
+	switch {
+	case errors.Is(err, gameserver.ErrPuzzleNotServed):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gameserver.ErrInvalidPuzzleMove):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &tictactoev1.PuzzleResult{
+		Correct:      attempt.Correct,
+		Solution:     int32(attempt.Solution),
+		PlayerRating: int32(attempt.Rating),
+		RatingChange: int32(attempt.RatingChange),
+	}, nil
+}
+
+func (s *serverAPI) ExportGame(ctx context.Context, req *tictactoev1.ExportGameRequest) (*tictactoev1.GameRecord, error) {
+	text, err := s.gameServer.ExportGame(ctx, req.GetGameId())
+	if errors.Is(err, gameserver.ErrGameNotFinished) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &tictactoev1.GameRecord{Text: text}, nil
+}
+
+func (s *serverAPI) ImportGame(ctx context.Context, req *tictactoev1.ImportGameRequest) (*tictactoev1.GameData, error) {
+	gameData, err := s.gameServer.ImportGame(ctx, req.GetText())
+	if errors.Is(err, gameserver.ErrInvalidRecord) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return game.GameToProto(gameData), nil
+}
+
+func (s *serverAPI) RenderGame(ctx context.Context, req *tictactoev1.RenderGameRequest) (*tictactoev1.RenderedImage, error) {
+	animated := req.GetFormat() == tictactoev1.ImageFormat_GIF
+	data, err := s.gameServer.RenderGame(ctx, req.GetGameId(), animated,
+		int(req.GetCellSize()), time.Duration(req.GetMoveDelayMs())*time.Millisecond)
+	if errors.Is(err, gameserver.ErrInvalidRenderOptions) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	contentType := "image/png"
+	if animated {
+		contentType = "image/gif"
+	}
+	return &tictactoev1.RenderedImage{Data: data, ContentType: contentType}, nil
+}
+
+func outcomeToProto(o solver.Outcome) tictactoev1.Outcome {
+	switch o {
+	case solver.Win:
+		return tictactoev1.Outcome_WIN
+	case solver.Loss:
+		return tictactoev1.Outcome_LOSS
+	default:
+		return tictactoev1.Outcome_DRAW
+	}
+}
+
+func (s *serverAPI) GetInvite(ctx context.Context, req *tictactoev1.GetInviteRequest) (*tictactoev1.Invite, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	invite, err := s.gameServer.GetInvite(ctx, req.GetGameId(), player.ID)
+	if errors.Is(err, gameserver.ErrNotGameCreator) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &tictactoev1.Invite{
+		GameId:            req.GetGameId(),
+		JoinCode:          invite.JoinCode,
+		JoinCodeExpiresAt: invite.JoinCodeExpiresAt.Unix(),
+	}, nil
+}
+
+func (s *serverAPI) RevokeJoinCode(ctx context.Context, req *tictactoev1.RevokeJoinCodeRequest) (*tictactoev1.RevokeJoinCodeResponse, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	err := s.gameServer.RevokeJoinCode(ctx, req.GetGameId(), player.ID)
+	if errors.Is(err, gameserver.ErrNotGameCreator) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &tictactoev1.RevokeJoinCodeResponse{}, nil
+}
+
+func (s *serverAPI) JoinByCode(ctx context.Context, req *tictactoev1.JoinByCodeRequest) (*tictactoev1.GameData, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	gameData, err := s.gameServer.JoinByCode(ctx, req.GetCode(), player)
+	switch {
+	case errors.Is(err, gameserver.ErrJoinCodeNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, gameserver.ErrTooManyAttempts):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return game.GameToProto(gameData), nil
+}
+
+func (s *serverAPI) ChallengePlayer(ctx context.Context, req *tictactoev1.ChallengePlayerRequest) (*tictactoev1.Challenge, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	challenge, err := s.gameServer.ChallengePlayer(ctx, player, req.GetPlayerId(), game.SettingsFromProto(req.GetSettings()))
+	switch {
+	case errors.Is(err, gameserver.ErrPlayerNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, gameserver.ErrInvalidChallenge):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return game.ChallengeToProto(challenge), nil
+}
+
+func (s *serverAPI) AcceptChallenge(ctx context.Context, req *tictactoev1.AcceptChallengeRequest) (*tictactoev1.GameData, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	gameData, err := s.gameServer.AcceptChallenge(ctx, player, req.GetChallengeId())
+	if errors.Is(err, gameserver.ErrChallengeNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return game.GameToProto(gameData), nil
+}
+
+func (s *serverAPI) DeclineChallenge(ctx context.Context, req *tictactoev1.DeclineChallengeRequest) (*tictactoev1.Challenge, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	challenge, err := s.gameServer.DeclineChallenge(ctx, player, req.GetChallengeId())
+	if errors.Is(err, gameserver.ErrChallengeNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return game.ChallengeToProto(challenge), nil
+}
+
+func (s *serverAPI) GetNotifications(req *tictactoev1.NotificationsRequest, stream tictactoev1.GameService_GetNotificationsServer) error {
+	player, err := s.streamPlayer(stream.Context())
+	if err != nil {
+		return err
+	}
+	notifications := s.gameServer.SubscribeNotifications(stream.Context(), player.ID)
+	for n := range notifications {
+		if err := stream.Send(n); err != nil {
+			return status.Error(codes.Internal, "failed to send notification")
+		}
+	}
+	return nil
+}
+
+// streamPlayer authenticates a streaming call, which the unary auth
+// interceptor doesn't see.
+func (s *serverAPI) streamPlayer(ctx context.Context) (*game.Player, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	playerIDs := md.Get("player-id")
+	if len(playerIDs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "player-id not provided")
+	}
+	player, exists := s.gameServer.GetPlayer(playerIDs[0])
+	if !exists {
+		return nil, status.Error(codes.Unauthenticated, "invalid player-id")
+	}
+	return player, nil
+}
+
+func (s *serverAPI) SendFriendRequest(ctx context.Context, req *tictactoev1.SendFriendRequestRequest) (*tictactoev1.SendFriendRequestResponse, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	accepted, err := s.gameServer.SendFriendRequest(ctx, player, req.GetPlayerId())
+	switch {
+	case errors.Is(err, gameserver.ErrInvalidFriendRequest):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, gameserver.ErrPlayerNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, gameserver.ErrAlreadyFriends), errors.Is(err, gameserver.ErrFriendRequestSent):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &tictactoev1.SendFriendRequestResponse{Accepted: accepted}, nil
+}
+
+func (s *serverAPI) AnswerFriendRequest(ctx context.Context, req *tictactoev1.AnswerFriendRequestRequest) (*tictactoev1.AnswerFriendRequestResponse, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	err := s.gameServer.AnswerFriendRequest(ctx, player, req.GetPlayerId(), req.GetAccept())
+	switch {
+	case errors.Is(err, gameserver.ErrFriendRequestNotFound), errors.Is(err, gameserver.ErrPlayerNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &tictactoev1.AnswerFriendRequestResponse{}, nil
+}
+
+func (s *serverAPI) ListFriendRequests(ctx context.Context, req *tictactoev1.ListFriendRequestsRequest) (*tictactoev1.FriendRequestList, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	list := &tictactoev1.FriendRequestList{}
+	for _, p := range s.gameServer.ListFriendRequests(ctx, player.ID) {
+		list.Players = append(list.Players, game.PlayerToProto(p))
+	}
+	return list, nil
+}
+
+func (s *serverAPI) RemoveFriend(ctx context.Context, req *tictactoev1.RemoveFriendRequest) (*tictactoev1.RemoveFriendResponse, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	err := s.gameServer.RemoveFriend(ctx, player, req.GetPlayerId())
+	if errors.Is(err, gameserver.ErrNotFriends) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &tictactoev1.RemoveFriendResponse{}, nil
+}
+
+func (s *serverAPI) ListFriends(ctx context.Context, req *tictactoev1.ListFriendsRequest) (*tictactoev1.FriendList, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	return &tictactoev1.FriendList{Friends: s.gameServer.ListFriends(ctx, player.ID)}, nil
+}
+
+func (s *serverAPI) Heartbeat(ctx context.Context, req *tictactoev1.HeartbeatRequest) (*tictactoev1.HeartbeatResponse, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	s.gameServer.Heartbeat(player.ID)
+	return &tictactoev1.HeartbeatResponse{
+		IntervalSeconds: int32(gameserver.HeartbeatInterval / time.Second),
+	}, nil
+}
+
+func (s *serverAPI) SubscribePresence(req *tictactoev1.SubscribePresenceRequest, stream tictactoev1.GameService_SubscribePresenceServer) error {
+	player, err := s.streamPlayer(stream.Context())
+	if err != nil {
+		return err
+	}
+	for update := range s.gameServer.SubscribePresence(stream.Context(), player.ID) {
+		if err := stream.Send(update); err != nil {
+			return status.Error(codes.Internal, "failed to send presence update")
+		}
+	}
+	return nil
+}
+
+func (s *serverAPI) SendChatMessage(ctx context.Context, req *tictactoev1.SendChatMessageRequest) (*tictactoev1.ChatMessage, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	msg, err := s.gameServer.SendChatMessage(ctx, req.GetGameId(), player, req.GetText())
+	switch {
+	case errors.Is(err, gameserver.ErrGameNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, gameserver.ErrChatRateLimited):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, gameserver.ErrChatMessageEmpty), errors.Is(err, gameserver.ErrChatMessageTooLong), errors.Is(err, gameserver.ErrChatMessageRejected):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return msg, nil
+}
+
+func (s *serverAPI) GetChat(req *tictactoev1.GetChatRequest, stream tictactoev1.GameService_GetChatServer) error {
+	player, err := s.streamPlayer(stream.Context())
+	if err != nil {
+		return err
+	}
+	messages, err := s.gameServer.SubscribeChat(stream.Context(), req.GetGameId(), player.ID)
+	if errors.Is(err, gameserver.ErrGameNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	for msg := range messages {
+		if err := stream.Send(msg); err != nil {
+			return status.Error(codes.Internal, "failed to send chat message")
+		}
+	}
+	return nil
+}
+
+func (s *serverAPI) SendReaction(ctx context.Context, req *tictactoev1.SendReactionRequest) (*tictactoev1.SendReactionResponse, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	err := s.gameServer.SendReaction(ctx, req.GetGameId(), player, req.GetType())
+	switch {
+	case errors.Is(err, gameserver.ErrGameNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, gameserver.ErrInvalidReaction):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, gameserver.ErrNotPlaying):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, gameserver.ErrGameOver):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gameserver.ErrChatRateLimited):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &tictactoev1.SendReactionResponse{}, nil
+}
+
+func (s *serverAPI) CreateTournament(ctx context.Context, req *tictactoev1.CreateTournamentRequest) (*tictactoev1.Tournament, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	settings := game.SettingsFromProto(req.GetSettings())
+	t, err := s.gameServer.CreateTournament(ctx, player, req.GetName(), req.GetFormat(), settings, int(req.GetBestOf()), int(req.GetRounds()), tournament.ScheduleFromProto(req))
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+	return tournament.ToProto(t, true), nil
+}
+
+func (s *serverAPI) ListTournaments(ctx context.Context, req *tictactoev1.ListTournamentsRequest) (*tictactoev1.TournamentList, error) {
+	return &tictactoev1.TournamentList{Tournaments: s.gameServer.ListTournaments(ctx)}, nil
+}
+
+func (s *serverAPI) RegisterForTournament(ctx context.Context, req *tictactoev1.RegisterForTournamentRequest) (*tictactoev1.Tournament, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	t, err := s.gameServer.RegisterForTournament(ctx, player, req.GetTournamentId())
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+	return t, nil
+}
+
+func (s *serverAPI) StartTournament(ctx context.Context, req *tictactoev1.StartTournamentRequest) (*tictactoev1.Tournament, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	t, err := s.gameServer.StartTournament(ctx, player, req.GetTournamentId())
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+	return t, nil
+}
+
+func (s *serverAPI) GetStandings(ctx context.Context, req *tictactoev1.GetStandingsRequest) (*tictactoev1.Standings, error) {
+	standings, err := s.gameServer.Standings(ctx, req.GetTournamentId())
+	if err != nil {
+		return nil, tournamentError(err)
+	}
+	return standings, nil
+}
+
+func (s *serverAPI) SubscribeStandings(req *tictactoev1.SubscribeStandingsRequest, stream tictactoev1.GameService_SubscribeStandingsServer) error {
+	if _, err := s.streamPlayer(stream.Context()); err != nil {
+		return err
+	}
+	updates, err := s.gameServer.SubscribeStandings(stream.Context(), req.GetTournamentId())
+	if err != nil {
+		return tournamentError(err)
+	}
+	for standings := range updates {
+		if err := stream.Send(standings); err != nil {
+			return status.Error(codes.Internal, "failed to send standings")
+		}
+	}
+	return nil
+}
+
+func (s *serverAPI) MyActiveGames(ctx context.Context, req *tictactoev1.MyActiveGamesRequest) (*tictactoev1.GameList, error) {
+	player, ok := ctx.Value("player").(*game.Player)
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth error")
+	}
+	return &tictactoev1.GameList{Games: s.gameServer.MyActiveGames(ctx, player.ID)}, nil
+}
+
+// tournamentError maps the errors of the tournament methods to status codes.
+func tournamentError(err error) error {
+	switch {
+	case errors.Is(err, gameserver.ErrTournamentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, gameserver.ErrNotTournamentCreator):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, tournament.ErrAlreadyRegistered):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, tournament.ErrNotRegistering), errors.Is(err, tournament.ErrTooFewPlayers),
+		errors.Is(err, tournament.ErrRegistrationClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gameserver.ErrInvalidTournament), errors.Is(err, tournament.ErrInvalidBestOf),
+		errors.Is(err, tournament.ErrInvalidSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
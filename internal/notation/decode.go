@@ -0,0 +1,159 @@
+package notation
+
+import (
+	"TicTacToe/internal/game"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Decode parses a record in the text notation. It only checks the syntax;
+// use Record.Replay to validate the moves against the rules.
+func Decode(text string) (Record, error) {
+	r := Record{Tags: make(map[string]string)}
+	tags := make(map[string]string)
+
+	var movetext strings.Builder
+	for n, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") {
+			name, value, err := parseTag(line)
+			if err != nil {
+				return Record{}, fmt.Errorf("line %d: %w", n+1, err)
+			}
+			tags[name] = value
+			continue
+		}
+		movetext.WriteString(line)
+		movetext.WriteByte(' ')
+	}
+
+	if err := r.applyTags(tags); err != nil {
+		return Record{}, err
+	}
+	if err := r.parseMoves(movetext.String()); err != nil {
+		return Record{}, err
+	}
+	return r, nil
+}
+
+func parseTag(line string) (string, string, error) {
+	inner, found := strings.CutSuffix(line[1:], "]")
+	if !found {
+		return "", "", errors.New("unterminated tag")
+	}
+	name, quoted, found := strings.Cut(inner, " ")
+	if !found || name == "" {
+		return "", "", fmt.Errorf("invalid tag %q", line)
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(quoted))
+	if err != nil {
+		return "", "", fmt.Errorf("invalid value of tag %s", name)
+	}
+	return name, value, nil
+}
+
+func (r *Record) applyTags(tags map[string]string) error {
+	// The board shape defaults to the one of the starting position, if any.
+	r.Start = tags["Position"]
+	r.Size, r.WinLength = 3, 3
+	if r.Start != "" {
+		start, err := game.ParsePosition(r.Start)
+		if err != nil {
+			return err
+		}
+		r.Size, r.WinLength = start.Size, start.WinLength
+	}
+	if v, ok := tags["Size"]; ok {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid Size tag %q", v)
+		}
+		r.Size = size
+		if r.Start == "" {
+			r.WinLength = min(size, 5)
+		}
+	}
+	if v, ok := tags["WinLength"]; ok {
+		winLength, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid WinLength tag %q", v)
+		}
+		r.WinLength = winLength
+	}
+	if v, ok := tags["Date"]; ok {
+		// Unknown dates are written as "????.??.??" and stay zero.
+		if date, err := time.Parse(dateLayout, v); err == nil {
+			r.Date = date
+		}
+	}
+
+	r.Event = tags["Event"]
+	r.X = tags["X"]
+	r.O = tags["O"]
+	r.TimeControl = tags["TimeControl"]
+	r.DaysPerMove = daysPerMove(r.TimeControl)
+	r.Result = tags["Result"]
+	r.Termination = tags["Termination"]
+
+	for name, value := range tags {
+		switch name {
+		case "Event", "Date", "X", "O", "Variant", "Size", "WinLength", "TimeControl", "Result", "Termination", "Position":
+		default:
+			r.Tags[name] = value
+		}
+	}
+	return nil
+}
+
+func (r *Record) parseMoves(movetext string) error {
+	result := ""
+	for _, token := range strings.Fields(stripComments(movetext)) {
+		if result != "" {
+			return fmt.Errorf("unexpected %q after the result", token)
+		}
+		switch {
+		case token == ResultXWins || token == ResultOWins || token == ResultDraw || token == ResultUnfinished:
+			result = token
+		case strings.HasSuffix(token, "."):
+			// Move number
+			if _, err := strconv.Atoi(strings.TrimRight(token, ".")); err != nil {
+				return fmt.Errorf("invalid move number %q", token)
+			}
+		default:
+			move, err := game.ParseCell(token, r.Size)
+			if err != nil {
+				return fmt.Errorf("move %d: %w", len(r.Moves)+1, err)
+			}
+			r.Moves = append(r.Moves, move)
+		}
+	}
+
+	switch {
+	case result == "" && r.Result == "":
+		r.Result = ResultUnfinished
+	case r.Result == "":
+		r.Result = result
+	case result != "" && result != r.Result:
+		return fmt.Errorf("result %s in the move list does not match the Result tag %s", result, r.Result)
+	}
+	return nil
+}
+
+func stripComments(s string) string {
+	var b strings.Builder
+	depth := 0
+	for _, c := range s {
+		switch {
+		case c == '{':
+			depth++
+		case c == '}' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package notation
+
+import (
+	"TicTacToe/internal/game"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Moves per line of the move list, counting X and O separately
+const movesPerLine = 16
+
+// Encode writes a record in the text notation.
+func Encode(r Record) string {
+	var b strings.Builder
+
+	writeTag(&b, "Event", r.Event)
+	date := "????.??.??"
+	if !r.Date.IsZero() {
+		date = r.Date.Format(dateLayout)
+	}
+	writeTag(&b, "Date", date)
+	writeTag(&b, "X", r.X)
+	writeTag(&b, "O", r.O)
+	writeTag(&b, "Variant", Variant(r.Size, r.WinLength))
+	writeTag(&b, "Size", strconv.Itoa(r.Size))
+	writeTag(&b, "WinLength", strconv.Itoa(r.WinLength))
+	writeTag(&b, "TimeControl", r.TimeControl)
+	writeTag(&b, "Result", r.Result)
+	if r.Termination != "" {
+		writeTag(&b, "Termination", r.Termination)
+	}
+	if r.Start != "" {
+		writeTag(&b, "Position", r.Start)
+	}
+
+	extra := make([]string, 0, len(r.Tags))
+	for name := range r.Tags {
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		writeTag(&b, name, r.Tags[name])
+	}
+
+	b.WriteByte('\n')
+
+	// Like in chess, a game where O moves first starts with "1..." and O's move.
+	offset := 0
+	if start, err := game.ParsePosition(r.Start); err == nil && start.ToMove == "O" {
+		offset = 1
+		if len(r.Moves) > 0 {
+			b.WriteString("1...")
+		}
+	}
+	for i, m := range r.Moves {
+		ply := i + offset
+		switch {
+		case i > 0 && ply%movesPerLine == 0:
+			b.WriteByte('\n')
+		case i > 0 || offset > 0:
+			b.WriteByte(' ')
+		}
+		if ply%2 == 0 {
+			fmt.Fprintf(&b, "%d. ", ply/2+1)
+		}
+		b.WriteString(game.CellName(m, r.Size))
+	}
+	if len(r.Moves) > 0 {
+		b.WriteByte(' ')
+	}
+	b.WriteString(r.Result)
+	b.WriteByte('\n')
+
+	return b.String()
+}
+
+func writeTag(b *strings.Builder, name, value string) {
+	fmt.Fprintf(b, "[%s %s]\n", name, strconv.Quote(value))
+}
@@ -0,0 +1,203 @@
+// Package notation reads and writes complete games in a PGN-like text format:
+//
+//	[Event "Casual game"]
+//	[Date "2024.09.14"]
+//	[X "alice"]
+//	[O "bob"]
+//	[Variant "standard"]
+//	[Size "3"]
+//	[WinLength "3"]
+//	[TimeControl "-"]
+//	[Result "1-0"]
+//
+//	1. b2 a1 2. c3 a3 3. a2 b1 4. c2 1-0
+//
+// Correspondence games record their time per move in the TimeControl tag as
+// one move in so many seconds, "1/259200" for three days a move. Games from a
+// custom starting position carry it in a Position tag, in the notation of
+// game.Position. Header tags are followed by a blank line and the move list.
+// Moves use the algebraic cell names of game.CellName, X always moves first. Text in braces
+// is a comment and ignored. The result is "1-0" when X wins, "0-1" when O wins,
+// "1/2-1/2" for a draw and "*" for an unfinished or abandoned game.
+package notation
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/utils"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ResultXWins      = "1-0"
+	ResultOWins      = "0-1"
+	ResultDraw       = "1/2-1/2"
+	ResultUnfinished = "*"
+)
+
+// Values of the Termination tag
+const (
+	TerminationNormal    = "normal"
+	TerminationAbandoned = "abandoned"
+)
+
+const dateLayout = "2006.01.02"
+
+// Record is a complete game with its header tags.
+type Record struct {
+	Event       string
+	Date        time.Time
+	X           string
+	O           string
+	Size        int
+	WinLength   int
+	TimeControl string
+	// DaysPerMove is read from TimeControl, 0 unless it is a correspondence game.
+	DaysPerMove int
+	Result      string
+	Termination string
+	// Start is the starting position in position notation, empty for an empty board.
+	Start string
+	// Tags holds header tags without a field of their own.
+	Tags  map[string]string
+	Moves []int
+}
+
+// Variant names the rule set for a board shape.
+func Variant(size, winLength int) string {
+	switch {
+	case size == 3 && winLength == 3:
+		return "standard"
+	case size == 15 && winLength == 5:
+		return "gomoku"
+	default:
+		return fmt.Sprintf("%d-in-a-row", winLength)
+	}
+}
+
+// FromGame builds the record of a game. Players that have left are recorded as "?".
+func FromGame(g *game.Game) Record {
+	r := Record{
+		Event:       "Casual game",
+		Date:        g.CreatedAt,
+		X:           playerName(g.PlayerX),
+		O:           playerName(g.PlayerO),
+		Size:        g.Size,
+		WinLength:   g.WinLength,
+		TimeControl: timeControl(g.DaysPerMove),
+		DaysPerMove: g.DaysPerMove,
+		Termination: TerminationNormal,
+		Start:       g.StartPosition,
+		Moves:       make([]int, len(g.Moves)),
+	}
+	for i, m := range g.Moves {
+		r.Moves[i] = int(m)
+	}
+
+	switch utils.CheckWin(g.Board, g.Size, g.WinLength) {
+	case "X":
+		r.Result = ResultXWins
+	case "O":
+		r.Result = ResultOWins
+	default:
+		r.Result = ResultUnfinished
+		if utils.IsBoardFull(g.Board) {
+			r.Result = ResultDraw
+		} else if g.Event == tictactoev1.GameEvent_PLAYER_LEAVED {
+			r.Termination = TerminationAbandoned
+		}
+	}
+	return r
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// timeControl writes the TimeControl tag of a game, "-" for a live game.
+func timeControl(daysPerMove int) string {
+	if daysPerMove <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("1/%d", daysPerMove*secondsPerDay)
+}
+
+// daysPerMove reads a correspondence TimeControl tag. Other time controls,
+// such as the "-" of live games, give 0.
+func daysPerMove(timeControl string) int {
+	moves, seconds, found := strings.Cut(timeControl, "/")
+	if !found || moves != "1" {
+		return 0
+	}
+	n, err := strconv.Atoi(seconds)
+	if err != nil || n <= 0 || n%secondsPerDay != 0 {
+		return 0
+	}
+	return n / secondsPerDay
+}
+
+func playerName(p *game.Player) string {
+	if p == nil {
+		return "?"
+	}
+	return p.Name
+}
+
+// Replay plays the moves through the rules and returns the final board. It
+// fails on illegal moves, moves after the end of the game and results that do
+// not match the final position.
+func (r Record) Replay() ([]string, error) {
+	if r.Size < 3 || r.WinLength < 3 || r.WinLength > r.Size {
+		return nil, fmt.Errorf("invalid board %dx%d with %d in a row", r.Size, r.Size, r.WinLength)
+	}
+
+	board := make([]string, r.Size*r.Size)
+	toMove := "X"
+	if r.Start != "" {
+		start, err := game.ParsePosition(r.Start)
+		if err != nil {
+			return nil, err
+		}
+		if start.Size != r.Size || start.WinLength != r.WinLength {
+			return nil, errors.New("starting position does not match the board size")
+		}
+		board = append([]string(nil), start.Board...)
+		toMove = start.ToMove
+	}
+
+	for i, m := range r.Moves {
+		if m < 0 || m >= len(board) {
+			return nil, fmt.Errorf("move %d is off the board", i+1)
+		}
+		if board[m] != "" {
+			return nil, fmt.Errorf("move %d: %s is already taken", i+1, game.CellName(m, r.Size))
+		}
+		if utils.CheckWin(board, r.Size, r.WinLength) != "" {
+			return nil, fmt.Errorf("move %d is played after the game was won", i+1)
+		}
+		board[m] = toMove
+		if toMove == "X" {
+			toMove = "O"
+		} else {
+			toMove = "X"
+		}
+	}
+
+	want := ResultUnfinished
+	switch utils.CheckWin(board, r.Size, r.WinLength) {
+	case "X":
+		want = ResultXWins
+	case "O":
+		want = ResultOWins
+	default:
+		if utils.IsBoardFull(board) {
+			want = ResultDraw
+		}
+	}
+	if r.Result != want {
+		return nil, fmt.Errorf("result %s does not match the final position, expected %s", r.Result, want)
+	}
+	return board, nil
+}
@@ -0,0 +1,51 @@
+package notation
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"strings"
+	"testing"
+)
+
+func TestTimeControl(t *testing.T) {
+	tests := []struct {
+		daysPerMove int
+		tag         string
+	}{
+		{0, "-"},
+		{1, "1/86400"},
+		{3, "1/259200"},
+		{14, "1/1209600"},
+	}
+	for _, tt := range tests {
+		g := &game.Game{
+			PlayerX:     &game.Player{Name: "alice"},
+			PlayerO:     &game.Player{Name: "bob"},
+			Board:       make([]string, 9),
+			Status:      tictactoev1.GameStatus_FINISHED,
+			Size:        3,
+			WinLength:   3,
+			DaysPerMove: tt.daysPerMove,
+		}
+		text := Encode(FromGame(g))
+		if !strings.Contains(text, `[TimeControl "`+tt.tag+`"]`) {
+			t.Errorf("%d days per move recorded as\n%s", tt.daysPerMove, text)
+		}
+		r, err := Decode(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.DaysPerMove != tt.daysPerMove {
+			t.Errorf("%s read back as %d days per move, want %d", tt.tag, r.DaysPerMove, tt.daysPerMove)
+		}
+	}
+}
+
+func TestOtherTimeControls(t *testing.T) {
+	// Time controls of other games aren't correspondence
+	for _, tag := range []string{"", "40/9000", "300+5", "*180", "1/3600", "1/x", "1/-86400"} {
+		if days := daysPerMove(tag); days != 0 {
+			t.Errorf("daysPerMove(%q) = %d, want 0", tag, days)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Package notifier delivers notifications to players who have no
+// notification stream open, such as through a push service.
+package notifier
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"context"
+)
+
+type Notifier interface {
+	Notify(ctx context.Context, playerID string, n *tictactoev1.Notification) error
+}
+
+// Func lets an ordinary function be used as a Notifier.
+type Func func(ctx context.Context, playerID string, n *tictactoev1.Notification) error
+
+func (f Func) Notify(ctx context.Context, playerID string, n *tictactoev1.Notification) error {
+	return f(ctx, playerID, n)
+}
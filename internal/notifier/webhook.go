@@ -0,0 +1,81 @@
+package notifier
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader carries the HMAC-SHA256 of the body, as "sha256=<hex>",
+// when the webhook has a secret.
+const SignatureHeader = "X-TicTacToe-Signature"
+
+// Webhook posts each notification as JSON to a URL:
+//
+//	{"player_id": "...", "notification": {...}}
+//
+// with the notification encoded like the REST gateway does. Any status other
+// than 2xx is an error.
+type Webhook struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhook creates a webhook notifier. Requests are signed when secret is
+// not empty, and give up after timeout.
+func NewWebhook(url, secret string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+type webhookPayload struct {
+	PlayerID     string          `json:"player_id"`
+	Notification json.RawMessage `json:"notification"`
+}
+
+func (w *Webhook) Notify(ctx context.Context, playerID string, n *tictactoev1.Notification) error {
+	notification, err := protojson.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+	body, err := json.Marshal(webhookPayload{PlayerID: playerID, Notification: notification})
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) > 0 {
+		mac := hmac.New(sha256.New, w.secret)
+		mac.Write(body)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+	// Read the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook failed: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package notifier
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var yourTurn = &tictactoev1.Notification{
+	Type: tictactoev1.NotificationType_YOUR_TURN,
+	Game: &tictactoev1.GameData{Id: "game-1"},
+}
+
+// request is what the test server received.
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts a server that records each request and answers with
+// status.
+func newReceiver(t *testing.T, status int) (*httptest.Server, <-chan request) {
+	t.Helper()
+	requests := make(chan request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{header: r.Header, body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func TestWebhookPayload(t *testing.T) {
+	srv, requests := newReceiver(t, http.StatusNoContent)
+
+	if err := NewWebhook(srv.URL, "", time.Second).Notify(context.Background(), "player-1", yourTurn); err != nil {
+		t.Fatal(err)
+	}
+	req := <-requests
+	if got := req.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	if got := req.header.Get(SignatureHeader); got != "" {
+		t.Errorf("unsigned webhook sent %s %q", SignatureHeader, got)
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.PlayerID != "player-1" {
+		t.Errorf("player_id = %q, want player-1", payload.PlayerID)
+	}
+	var n tictactoev1.Notification
+	if err := protojson.Unmarshal(payload.Notification, &n); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(&n, yourTurn) {
+		t.Errorf("notification = %v, want %v", &n, yourTurn)
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	srv, requests := newReceiver(t, http.StatusOK)
+
+	if err := NewWebhook(srv.URL, "secret", time.Second).Notify(context.Background(), "player-1", yourTurn); err != nil {
+		t.Fatal(err)
+	}
+	req := <-requests
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(req.body)
+	if want, got := "sha256="+hex.EncodeToString(mac.Sum(nil)), req.header.Get(SignatureHeader); got != want {
+		t.Errorf("%s = %q, want %q", SignatureHeader, got, want)
+	}
+}
+
+func TestWebhookErrorStatus(t *testing.T) {
+	srv, _ := newReceiver(t, http.StatusInternalServerError)
+
+	if err := NewWebhook(srv.URL, "", time.Second).Notify(context.Background(), "player-1", yourTurn); err == nil {
+		t.Error("webhook answered 500, want an error")
+	}
+}
+
+func TestWebhookTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	// Runs before srv.Close, which waits for the handler
+	t.Cleanup(func() { close(release) })
+
+	start := time.Now()
+	err := NewWebhook(srv.URL, "", 50*time.Millisecond).Notify(context.Background(), "player-1", yourTurn)
+	if err == nil {
+		t.Fatal("webhook never answered, want an error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("webhook gave up after %v, want about the timeout", elapsed)
+	}
+}
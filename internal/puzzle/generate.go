@@ -0,0 +1,135 @@
+package puzzle
+
+import (
+	"TicTacToe/internal/canon"
+	"TicTacToe/internal/solver"
+	"TicTacToe/internal/utils"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+)
+
+// Options describe the puzzles to generate for one board shape.
+type Options struct {
+	Size      int
+	WinLength int
+	Count     int
+	// Only wins in MinMovesToWin to MaxMovesToWin moves are kept.
+	MinMovesToWin int
+	MaxMovesToWin int
+	// MinEmpty skips positions with too few choices to be interesting.
+	MinEmpty int
+	// MaxEmpty skips positions with more empty cells, which are slow to solve.
+	MaxEmpty int
+	// Games caps the number of random games sampled for positions.
+	Games int
+	Seed  int64
+}
+
+// Generate samples positions from random games and keeps those with a unique
+// winning move. Positions that are rotations or reflections of an earlier
+// puzzle are skipped.
+func Generate(opts Options) ([]Puzzle, error) {
+	s := solver.NewWithOptions(opts.Size, opts.WinLength, solver.Options{TableBits: 20})
+	geo := canon.NewGeometry(opts.Size, opts.Size)
+	rnd := rand.New(rand.NewSource(opts.Seed))
+	seen := make(map[string]bool)
+
+	var puzzles []Puzzle
+	for g := 0; g < opts.Games && len(puzzles) < opts.Count; g++ {
+		board := make([]string, opts.Size*opts.Size)
+		for _, move := range rnd.Perm(len(board)) {
+			if utils.CheckWin(board, opts.Size, opts.WinLength) != "" {
+				break
+			}
+			if empties := countEmpty(board); empties >= opts.MinEmpty && empties <= opts.MaxEmpty {
+				p, ok, err := candidate(s, board, opts)
+				if err != nil {
+					return nil, err
+				}
+				key := canonicalKey(geo, board)
+				if ok && !seen[key] {
+					seen[key] = true
+					p.ID = puzzleID(opts.Size, opts.WinLength, key)
+					puzzles = append(puzzles, p)
+					if len(puzzles) == opts.Count {
+						break
+					}
+				}
+			}
+			board[move] = sideToMove(board)
+		}
+	}
+	return puzzles, nil
+}
+
+// candidate turns a position into a puzzle if it has exactly one winning move.
+func candidate(s *solver.Solver, board []string, opts Options) (Puzzle, bool, error) {
+	moves, err := s.Evaluate(board)
+	if err != nil {
+		return Puzzle{}, false, err
+	}
+
+	var winning []solver.Move
+	for _, m := range moves {
+		if m.Result.Outcome == solver.Win {
+			winning = append(winning, m)
+		}
+	}
+	if len(winning) != 1 {
+		return Puzzle{}, false, nil
+	}
+
+	// A win at distance d plies takes (d+1)/2 moves of the winner.
+	movesToWin := (winning[0].Result.Distance + 1) / 2
+	if movesToWin < opts.MinMovesToWin || movesToWin > opts.MaxMovesToWin {
+		return Puzzle{}, false, nil
+	}
+	return Puzzle{
+		Board:      append([]string(nil), board...),
+		Size:       opts.Size,
+		WinLength:  opts.WinLength,
+		MovesToWin: movesToWin,
+		Solution:   winning[0].Position,
+		Rating:     Difficulty(movesToWin, countEmpty(board)),
+	}, true, nil
+}
+
+func canonicalKey(geo *canon.Geometry, board []string) string {
+	cells := make([]byte, len(board))
+	for i, v := range board {
+		switch v {
+		case "X":
+			cells[i] = 'X'
+		case "O":
+			cells[i] = 'O'
+		default:
+			cells[i] = '.'
+		}
+	}
+	c, _ := geo.Canonical(cells)
+	return string(c)
+}
+
+func puzzleID(size, winLength int, key string) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%d/%s", size, winLength, key)
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func countEmpty(board []string) int {
+	n := 0
+	for _, v := range board {
+		if v == "" {
+			n++
+		}
+	}
+	return n
+}
+
+func sideToMove(board []string) string {
+	if countEmpty(board)%2 == len(board)%2 {
+		return "X"
+	}
+	return "O"
+}
@@ -0,0 +1,33 @@
+// Package puzzle generates and rates "win in N" puzzles: positions where the
+// side to move has exactly one move that leads to a forced win.
+package puzzle
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// Puzzle is a position with a unique winning move for the side to move.
+type Puzzle struct {
+	ID        string   `json:"id"`
+	Board     []string `json:"board"`
+	Size      int      `json:"size"`
+	WinLength int      `json:"win_length"`
+	// MovesToWin counts the solver's own moves, including the final one.
+	MovesToWin int `json:"moves_to_win"`
+	Solution   int `json:"solution"`
+	Rating     int `json:"rating"`
+}
+
+//go:embed puzzles.json
+var builtin []byte
+
+// Builtin returns the puzzle set shipped with the server, generated by cmd/puzzlegen.
+func Builtin() ([]Puzzle, error) {
+	var puzzles []Puzzle
+	if err := json.Unmarshal(builtin, &puzzles); err != nil {
+		return nil, fmt.Errorf("failed to decode builtin puzzles: %w", err)
+	}
+	return puzzles, nil
+}
@@ -0,0 +1,28 @@
+package puzzle
+
+import "math"
+
+const (
+	// DefaultRating is the puzzle rating of a player without attempts.
+	DefaultRating = 1200
+	// K-factor of the Elo updates for players and puzzles
+	ratingK = 32
+)
+
+// Difficulty is the starting rating of a freshly generated puzzle. Longer
+// wins and more candidate moves make a puzzle harder.
+func Difficulty(movesToWin, emptyCells int) int {
+	return 700 + 300*(movesToWin-1) + 25*emptyCells
+}
+
+// Rate returns the new player and puzzle ratings after an attempt. The puzzle
+// is treated as the player's opponent in an Elo game.
+func Rate(player, puzzle int, solved bool) (newPlayer, newPuzzle int) {
+	expected := 1 / (1 + math.Pow(10, float64(puzzle-player)/400))
+	score := 0.0
+	if solved {
+		score = 1
+	}
+	delta := int(math.Round(ratingK * (score - expected)))
+	return player + delta, puzzle - delta
+}
@@ -0,0 +1,13 @@
+package render
+
+import (
+	"image/color"
+	"image/color/palette"
+)
+
+// gifPalette holds the theme colors exactly, so flat areas are not dithered,
+// followed by the web-safe colors for the piece artwork.
+var gifPalette = func() color.Palette {
+	p := color.Palette{backgroundColor, cellColor, lastMoveColor, winningColor}
+	return append(p, palette.WebSafe...)
+}()
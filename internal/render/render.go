@@ -0,0 +1,219 @@
+// Package render draws boards and finished games to PNG and animated GIF
+// images, with the same piece artwork as the client.
+package render
+
+import (
+	"TicTacToe/internal/game"
+	"TicTacToe/resources"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	DefaultCellSize = 96
+	MinCellSize     = 16
+	MaxCellSize     = 256
+
+	// Frame delay of the last GIF frame, so the final position can be seen
+	finalFrameDelay = 3 * time.Second
+)
+
+// Colors of the client's dark theme
+var (
+	backgroundColor = color.NRGBA{R: 18, G: 18, B: 18, A: 255}
+	cellColor       = color.NRGBA{R: 38, G: 38, B: 38, A: 255}
+	lastMoveColor   = color.NRGBA{R: 70, G: 130, B: 180, A: 255}
+	winningColor    = color.NRGBA{R: 46, G: 204, B: 113, A: 255}
+)
+
+// Renderer draws boards with cells of a fixed size in pixels.
+type Renderer struct {
+	cellSize int
+	x, o     image.Image
+}
+
+var (
+	pieces     struct{ x, o image.Image }
+	piecesOnce sync.Once
+	piecesErr  error
+	// Renderers by cell size, scaling the artwork is the expensive part
+	renderers sync.Map
+)
+
+func loadPieces() {
+	pieces.x, piecesErr = png.Decode(bytes.NewReader(resources.XImage))
+	if piecesErr != nil {
+		return
+	}
+	pieces.o, piecesErr = png.Decode(bytes.NewReader(resources.OImage))
+}
+
+// New returns a renderer for the cell size. Renderers are cached and safe for
+// concurrent use.
+func New(cellSize int) (*Renderer, error) {
+	if cellSize == 0 {
+		cellSize = DefaultCellSize
+	}
+	if cellSize < MinCellSize || cellSize > MaxCellSize {
+		return nil, fmt.Errorf("cell size must be between %d and %d pixels", MinCellSize, MaxCellSize)
+	}
+
+	piecesOnce.Do(loadPieces)
+	if piecesErr != nil {
+		return nil, fmt.Errorf("failed to decode piece artwork: %w", piecesErr)
+	}
+
+	if r, ok := renderers.Load(cellSize); ok {
+		return r.(*Renderer), nil
+	}
+	pieceSize := cellSize * 3 / 4
+	r, _ := renderers.LoadOrStore(cellSize, &Renderer{
+		cellSize: cellSize,
+		x:        scale(pieces.x, pieceSize),
+		o:        scale(pieces.o, pieceSize),
+	})
+	return r.(*Renderer), nil
+}
+
+func scale(src image.Image, size int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+	return dst
+}
+
+// gap is the space between cells and around the board.
+func (r *Renderer) gap() int {
+	return max(2, r.cellSize/24)
+}
+
+// Board draws a board. lastMove is highlighted unless it is negative, and the
+// cells of a completed line are highlighted when winLength is positive.
+func (r *Renderer) Board(board []string, size, winLength, lastMove int) *image.RGBA {
+	gap := r.gap()
+	side := size*r.cellSize + (size+1)*gap
+	img := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(img, img.Bounds(), image.NewUniform(backgroundColor), image.Point{}, draw.Src)
+
+	winning := winningCells(board, size, winLength)
+	pieceOffset := (r.cellSize - r.x.Bounds().Dx()) / 2
+	for i, cell := range board {
+		topLeft := image.Pt(gap+(i%size)*(r.cellSize+gap), gap+(i/size)*(r.cellSize+gap))
+		rect := image.Rectangle{Min: topLeft, Max: topLeft.Add(image.Pt(r.cellSize, r.cellSize))}
+
+		fill := cellColor
+		switch {
+		case winning[i]:
+			fill = winningColor
+		case i == lastMove:
+			fill = lastMoveColor
+		}
+		draw.Draw(img, rect, image.NewUniform(fill), image.Point{}, draw.Src)
+
+		var piece image.Image
+		switch cell {
+		case "X":
+			piece = r.x
+		case "O":
+			piece = r.o
+		default:
+			continue
+		}
+		at := topLeft.Add(image.Pt(pieceOffset, pieceOffset))
+		draw.Draw(img, piece.Bounds().Add(at), piece, image.Point{}, draw.Over)
+	}
+	return img
+}
+
+// PNG writes the current board of a game.
+func (r *Renderer) PNG(w io.Writer, g *game.Game) error {
+	last := -1
+	if len(g.Moves) > 0 {
+		last = int(g.Moves[len(g.Moves)-1])
+	}
+	return png.Encode(w, r.Board(g.Board, g.Size, g.WinLength, last))
+}
+
+// GIF writes an animation of the game from its starting position, one frame per move.
+func (r *Renderer) GIF(w io.Writer, g *game.Game, moveDelay time.Duration) error {
+	board := make([]string, g.Size*g.Size)
+	if g.StartPosition != "" {
+		start, err := game.ParsePosition(g.StartPosition)
+		if err != nil {
+			return err
+		}
+		board = append([]string(nil), start.Board...)
+	}
+	toMove := game.NewPosition(board, g.Size, g.WinLength).ToMove
+
+	anim := &gif.GIF{}
+	addFrame := func(last int, delay time.Duration) {
+		frame := r.Board(board, g.Size, g.WinLength, last)
+		paletted := image.NewPaletted(frame.Bounds(), gifPalette)
+		draw.FloydSteinberg.Draw(paletted, paletted.Bounds(), frame, image.Point{})
+		anim.Image = append(anim.Image, paletted)
+		anim.Delay = append(anim.Delay, int(delay/(10*time.Millisecond)))
+	}
+
+	addFrame(-1, moveDelay)
+	for _, m := range g.Moves {
+		if m < 0 || int(m) >= len(board) || board[m] != "" {
+			return errors.New("game has an invalid move history")
+		}
+		board[m] = toMove
+		if toMove == "X" {
+			toMove = "O"
+		} else {
+			toMove = "X"
+		}
+		addFrame(int(m), moveDelay)
+	}
+	anim.Delay[len(anim.Delay)-1] = int(finalFrameDelay / (10 * time.Millisecond))
+
+	return gif.EncodeAll(w, anim)
+}
+
+// winningCells marks the cells of every completed line.
+func winningCells(board []string, size, winLength int) []bool {
+	cells := make([]bool, len(board))
+	if winLength <= 0 {
+		return cells
+	}
+	dirs := [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+	for r := 0; r < size; r++ {
+		for c := 0; c < size; c++ {
+			first := board[r*size+c]
+			if first == "" {
+				continue
+			}
+			for _, d := range dirs {
+				endR, endC := r+d[0]*(winLength-1), c+d[1]*(winLength-1)
+				if endR < 0 || endR >= size || endC < 0 || endC >= size {
+					continue
+				}
+				complete := true
+				for k := 1; k < winLength; k++ {
+					if board[(r+d[0]*k)*size+c+d[1]*k] != first {
+						complete = false
+						break
+					}
+				}
+				if complete {
+					for k := 0; k < winLength; k++ {
+						cells[(r+d[0]*k)*size+c+d[1]*k] = true
+					}
+				}
+			}
+		}
+	}
+	return cells
+}
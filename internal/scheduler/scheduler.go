@@ -0,0 +1,73 @@
+// Package scheduler runs jobs at set times.
+package scheduler
+
+import (
+	"TicTacToe/internal/clock"
+	"sync"
+	"time"
+)
+
+// Scheduler runs each job on its own goroutine when its time comes. Jobs
+// have keys, so scheduling a job with the key of a pending one replaces it.
+type Scheduler struct {
+	clock clock.Clock
+	jobs  map[string]*job
+	mu    sync.Mutex
+}
+
+type job struct {
+	timer clock.Timer
+}
+
+func New(c clock.Clock) *Scheduler {
+	return &Scheduler{clock: c, jobs: make(map[string]*job)}
+}
+
+// Now returns the time on the clock of the scheduler.
+func (s *Scheduler) Now() time.Time {
+	return s.clock.Now()
+}
+
+// At runs f at the given time, or right away if it has passed.
+func (s *Scheduler) At(key string, at time.Time, f func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pending, ok := s.jobs[key]; ok {
+		pending.timer.Stop()
+	}
+	j := &job{}
+	j.timer = s.clock.AfterFunc(at.Sub(s.clock.Now()), func() {
+		s.mu.Lock()
+		current := s.jobs[key] == j
+		if current {
+			delete(s.jobs, key)
+		}
+		s.mu.Unlock()
+		// A job replaced just as it was due doesn't run
+		if current {
+			f()
+		}
+	})
+	s.jobs[key] = j
+}
+
+// Cancel drops a pending job.
+func (s *Scheduler) Cancel(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if pending, ok := s.jobs[key]; ok {
+		pending.timer.Stop()
+		delete(s.jobs, key)
+	}
+}
+
+// Stop drops all pending jobs.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, pending := range s.jobs {
+		pending.timer.Stop()
+		delete(s.jobs, key)
+	}
+}
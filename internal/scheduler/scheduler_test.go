@@ -0,0 +1,108 @@
+package scheduler
+
+import (
+	"TicTacToe/internal/clock"
+	"slices"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func TestJobsRunWhenDue(t *testing.T) {
+	clk := clock.NewFake(start)
+	s := New(clk)
+
+	var ran []string
+	s.At("late", start.Add(2*time.Hour), func() { ran = append(ran, "late") })
+	s.At("early", start.Add(time.Hour), func() { ran = append(ran, "early") })
+
+	clk.Advance(time.Hour - time.Second)
+	if len(ran) != 0 {
+		t.Fatalf("ran %v before their time", ran)
+	}
+	clk.Advance(2 * time.Hour)
+	if want := []string{"early", "late"}; !slices.Equal(ran, want) {
+		t.Errorf("ran %v, want %v", ran, want)
+	}
+}
+
+func TestPastJobRunsRightAway(t *testing.T) {
+	clk := clock.NewFake(start)
+	s := New(clk)
+
+	ran := false
+	s.At("past", start.Add(-time.Hour), func() { ran = true })
+	clk.Advance(0)
+	if !ran {
+		t.Error("job scheduled in the past did not run")
+	}
+}
+
+func TestJobWithSameKeyReplaces(t *testing.T) {
+	clk := clock.NewFake(start)
+	s := New(clk)
+
+	var ran []string
+	s.At("job", start.Add(time.Hour), func() { ran = append(ran, "first") })
+	s.At("job", start.Add(2*time.Hour), func() { ran = append(ran, "second") })
+
+	clk.Advance(time.Hour)
+	if len(ran) != 0 {
+		t.Fatalf("replaced job ran: %v", ran)
+	}
+	clk.Advance(time.Hour)
+	if want := []string{"second"}; !slices.Equal(ran, want) {
+		t.Errorf("ran %v, want %v", ran, want)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	clk := clock.NewFake(start)
+	s := New(clk)
+
+	var ran []string
+	s.At("cancelled", start.Add(time.Hour), func() { ran = append(ran, "cancelled") })
+	s.At("kept", start.Add(time.Hour), func() { ran = append(ran, "kept") })
+	s.Cancel("cancelled")
+	s.Cancel("unknown")
+
+	clk.Advance(time.Hour)
+	if want := []string{"kept"}; !slices.Equal(ran, want) {
+		t.Errorf("ran %v, want %v", ran, want)
+	}
+}
+
+func TestStop(t *testing.T) {
+	clk := clock.NewFake(start)
+	s := New(clk)
+
+	ran := false
+	s.At("a", start.Add(time.Hour), func() { ran = true })
+	s.At("b", start.Add(2*time.Hour), func() { ran = true })
+	s.Stop()
+
+	clk.Advance(3 * time.Hour)
+	if ran {
+		t.Error("job ran after Stop")
+	}
+}
+
+func TestJobCanReschedule(t *testing.T) {
+	clk := clock.NewFake(start)
+	s := New(clk)
+
+	// Jobs run outside the scheduler's lock, so one may schedule the next
+	runs := 0
+	var tick func()
+	tick = func() {
+		runs++
+		s.At("tick", s.Now().Add(time.Hour), tick)
+	}
+	s.At("tick", start.Add(time.Hour), tick)
+
+	clk.Advance(3 * time.Hour)
+	if runs != 3 {
+		t.Errorf("job ran %d times, want 3", runs)
+	}
+}
@@ -0,0 +1,131 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/game"
+	"context"
+	"io"
+	"log/slog"
+	"time"
+)
+
+// How long a bot game may go without its player watching before it is
+// abandoned, which forfeits it and releases the engine
+const abandonTimeout = 10 * time.Minute
+
+// Scheduler job of a bot game, see gameJob
+const jobAbandoned = "abandoned"
+
+// BotNames lists the bot difficulties that games can be created with.
+func (gs *GameServer) BotNames() []string {
+	return gs.bots.Names()
+}
+
+// playBotMove lets the engine think in the background and plays its move
+// through MakeMove like any other player.
+func (gs *GameServer) playBotMove(gameID string, engine bot.Engine) {
+	ctx, cancel := context.WithTimeout(context.Background(), gs.botMoveTime)
+	defer cancel()
+
+	// Search a copy of the board, as moves may come in while the bot thinks
+	gs.mu.RLock()
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		gs.mu.RUnlock()
+		return
+	}
+	botPlayer := gameData.PlayerO
+	pos := bot.Position{
+		Board:     append([]string(nil), gameData.Board...),
+		Size:      gameData.Size,
+		WinLength: gameData.WinLength,
+	}
+	gs.mu.RUnlock()
+
+	move, err := engine.BestMove(ctx, pos)
+	if err != nil {
+		// Keep the game going rather than leaving the human waiting forever.
+		slog.Error("Bot failed to move, playing a random move", "game_id", gameID,
+			"position", game.FormatPosition(pos.Board, pos.Size, pos.WinLength), "error", err)
+		move, err = bot.Random{}.BestMove(ctx, pos)
+		if err != nil {
+			return
+		}
+	}
+
+	if _, err := gs.MakeMove(context.Background(), gameID, botPlayer, int32(move)); err != nil {
+		slog.Error("Bot move rejected", "game_id", gameID,
+			"position", game.FormatPosition(pos.Board, pos.Size, pos.WinLength), "move", move, "error", err)
+	}
+}
+
+// releaseEngine forgets the bot of a finished, abandoned or deleted game.
+// Engines holding resources, such as external processes, are closed in the
+// background, as that may take a while and callers may hold gs.mu.
+func (gs *GameServer) releaseEngine(gameID string) {
+	gs.enginesMu.Lock()
+	engine, ok := gs.engines[gameID]
+	delete(gs.engines, gameID)
+	delete(gs.watchers, gameID)
+	gs.enginesMu.Unlock()
+	gs.scheduler.Cancel(gameJob(gameID, jobAbandoned))
+
+	if closer, isCloser := engine.(io.Closer); ok && isCloser {
+		go func() {
+			if err := closer.Close(); err != nil {
+				slog.Error("Failed to close bot engine", "game_id", gameID, "error", err)
+			}
+		}()
+	}
+}
+
+// watchBotGame counts a stream of the player of a bot game until ctx is
+// done. The game is abandoned once nobody has watched it for abandonTimeout.
+func (gs *GameServer) watchBotGame(ctx context.Context, gameID string) {
+	gs.enginesMu.Lock()
+	defer gs.enginesMu.Unlock()
+
+	if _, ok := gs.engines[gameID]; !ok {
+		return
+	}
+	gs.watchers[gameID]++
+	gs.scheduler.Cancel(gameJob(gameID, jobAbandoned))
+
+	go func() {
+		<-ctx.Done()
+
+		gs.enginesMu.Lock()
+		defer gs.enginesMu.Unlock()
+		if _, ok := gs.engines[gameID]; !ok {
+			return
+		}
+		if gs.watchers[gameID]--; gs.watchers[gameID] == 0 {
+			gs.scheduleAbandon(gameID)
+		}
+	}()
+}
+
+func (gs *GameServer) scheduleAbandon(gameID string) {
+	gs.scheduler.At(gameJob(gameID, jobAbandoned), gs.scheduler.Now().Add(abandonTimeout), func() {
+		gs.abandonBotGame(gameID)
+	})
+}
+
+// abandonBotGame makes the player of a bot game nobody watches leave it.
+func (gs *GameServer) abandonBotGame(gameID string) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	ctx := context.Background()
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists || gameData.Status == tictactoev1.GameStatus_FINISHED || gameData.PlayerX == nil {
+		gs.releaseEngine(gameID)
+		return
+	}
+	slog.Info("Bot game abandoned", "game_id", gameID, "player_id", gameData.PlayerX.ID)
+	if _, err := gs.leaveGame(ctx, gameID, gameData.PlayerX.ID); err != nil {
+		slog.Error("Failed to abandon bot game", "game_id", gameID, "error", err)
+		gs.releaseEngine(gameID)
+	}
+}
@@ -0,0 +1,124 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/clock"
+	"TicTacToe/internal/game"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// closingEngine plays a random move and records whether it was closed. With
+// a quit channel, closing waits until it is closed.
+type closingEngine struct {
+	closed *atomic.Bool
+	quit   chan struct{}
+}
+
+func (e closingEngine) BestMove(ctx context.Context, pos bot.Position) (int, error) {
+	return bot.Random{}.BestMove(ctx, pos)
+}
+
+func (e closingEngine) Close() error {
+	if e.quit != nil {
+		<-e.quit
+	}
+	e.closed.Store(true)
+	return nil
+}
+
+func newBotGame(t *testing.T) (*GameServer, *clock.Fake, *game.Game, *atomic.Bool) {
+	t.Helper()
+	return newBotGameWith(t, nil)
+}
+
+func newBotGameWith(t *testing.T, quit chan struct{}) (*GameServer, *clock.Fake, *game.Game, *atomic.Bool) {
+	t.Helper()
+	closed := new(atomic.Bool)
+	bots := bot.NewRegistry()
+	bots.Register("closing", func(size, winLength int) (bot.Engine, error) {
+		return closingEngine{closed: closed, quit: quit}, nil
+	})
+	gs, clk := newTestServer(t, bots)
+	g, err := gs.CreateGame(context.Background(), login(t, gs, "human"), game.Settings{Size: 3, WinLength: 3, Bot: "closing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gs, clk, g, closed
+}
+
+func TestUnwatchedBotGameIsAbandoned(t *testing.T) {
+	gs, clk, g, closed := newBotGame(t)
+
+	clk.Advance(abandonTimeout - time.Second)
+	if closed.Load() {
+		t.Fatal("engine closed before the game was abandoned")
+	}
+	clk.Advance(time.Second)
+	eventually(t, "the engine of the abandoned game is closed", closed.Load)
+	if _, exists := gs.GetGame(g.ID); exists {
+		t.Error("abandoned game was not deleted")
+	}
+}
+
+func TestWatchedBotGameIsKept(t *testing.T) {
+	gs, clk, g, closed := newBotGame(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, _, err := gs.Subscribe(ctx, g.ID, g.PlayerX.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	clk.Advance(2 * abandonTimeout)
+	if closed.Load() {
+		t.Fatal("engine of a watched game was closed")
+	}
+
+	// The game is abandoned once its player stops watching
+	cancel()
+	eventually(t, "the abandonment is scheduled", func() bool {
+		gs.enginesMu.Lock()
+		defer gs.enginesMu.Unlock()
+		return gs.watchers[g.ID] == 0
+	})
+	clk.Advance(abandonTimeout)
+	eventually(t, "the engine of the abandoned game is closed", closed.Load)
+}
+
+func TestFinishedBotGameReleasesEngine(t *testing.T) {
+	gs, _, g, closed := newBotGame(t)
+
+	if _, err := gs.LeaveGame(context.Background(), g.ID, g.PlayerX.ID); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the engine is closed", closed.Load)
+	if g.Status != tictactoev1.GameStatus_FINISHED {
+		t.Errorf("status = %v, want FINISHED", g.Status)
+	}
+}
+
+func TestSlowEngineCloseDoesNotBlockServer(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+	gs, _, g, closed := newBotGameWith(t, quit)
+
+	left := make(chan error, 1)
+	go func() {
+		_, err := gs.LeaveGame(context.Background(), g.ID, g.PlayerX.ID)
+		left <- err
+	}()
+	select {
+	case err := <-left:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(2 * time.Second):
+		// LeaveGame holds gs.mu, which would stall the whole server
+		t.Fatal("leaving waited for the engine to close")
+	}
+	if closed.Load() {
+		t.Error("engine closed before it quit")
+	}
+}
@@ -0,0 +1,133 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrChallengeNotFound = errors.New("challenge not found")
+	ErrPlayerNotFound    = errors.New("player not found")
+	ErrInvalidChallenge  = errors.New("invalid challenge")
+)
+
+// Challenges can be accepted for this long.
+const challengeTTL = 10 * time.Minute
+
+// ChallengePlayer invites a player to a game with the given settings. The
+// challenged player is notified and can accept or decline.
+func (gs *GameServer) ChallengePlayer(ctx context.Context, from *game.Player, toID string, settings game.Settings) (*game.Challenge, error) {
+	if toID == from.ID {
+		return nil, fmt.Errorf("%w: you can't challenge yourself", ErrInvalidChallenge)
+	}
+	if settings.Bot != "" {
+		return nil, fmt.Errorf("%w: challenges are between players, create a game to play a bot", ErrInvalidChallenge)
+	}
+	to, exists := gs.storage.GetPlayer(ctx, toID)
+	if !exists {
+		return nil, ErrPlayerNotFound
+	}
+
+	// Catch bad settings now rather than when the game is created.
+	var err error
+	if settings.StartPosition != "" {
+		_, err = startPosition(settings.StartPosition)
+	} else {
+		_, _, err = boardShape(settings.Size, settings.WinLength)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidChallenge, err)
+	}
+	// Both seats are filled when the game is created, nobody needs a password.
+	settings.Password = ""
+
+	now := time.Now()
+	challenge := &game.Challenge{
+		ID:        utils.GenerateUniqueID(),
+		From:      from,
+		To:        to,
+		Settings:  settings,
+		ExpiresAt: now.Add(challengeTTL),
+	}
+
+	gs.challengesMu.Lock()
+	defer gs.challengesMu.Unlock()
+	for id, c := range gs.challenges {
+		if now.After(c.ExpiresAt) {
+			delete(gs.challenges, id)
+		}
+	}
+	gs.challenges[challenge.ID] = challenge
+	gs.notify(to.ID, challengeNotification(tictactoev1.NotificationType_CHALLENGE_RECEIVED, challenge))
+	return challenge, nil
+}
+
+// AcceptChallenge creates the game of a challenge the player received, with
+// both players already seated.
+func (gs *GameServer) AcceptChallenge(ctx context.Context, player *game.Player, challengeID string) (*game.Game, error) {
+	gs.challengesMu.Lock()
+	challenge, ok := gs.challenges[challengeID]
+	if !ok || challenge.To.ID != player.ID || time.Now().After(challenge.ExpiresAt) {
+		gs.challengesMu.Unlock()
+		return nil, ErrChallengeNotFound
+	}
+	delete(gs.challenges, challengeID)
+	gs.challengesMu.Unlock()
+
+	newGame, err := gs.CreateGame(ctx, challenge.From, challenge.Settings)
+	if err != nil {
+		return nil, err
+	}
+	newGame, err = gs.JoinGame(ctx, newGame.ID, challenge.To, "")
+	if err != nil {
+		return nil, err
+	}
+
+	challenge.GameID = newGame.ID
+	accepted := challengeNotification(tictactoev1.NotificationType_CHALLENGE_ACCEPTED, challenge)
+	accepted.Game = game.GameToProto(newGame)
+	gs.notify(challenge.From.ID, accepted)
+	return newGame, nil
+}
+
+// DeclineChallenge declines a challenge the player received, or withdraws one
+// they sent. The other player is notified.
+func (gs *GameServer) DeclineChallenge(ctx context.Context, player *game.Player, challengeID string) (*game.Challenge, error) {
+	gs.challengesMu.Lock()
+	challenge, ok := gs.challenges[challengeID]
+	if !ok || (challenge.To.ID != player.ID && challenge.From.ID != player.ID) {
+		gs.challengesMu.Unlock()
+		return nil, ErrChallengeNotFound
+	}
+	delete(gs.challenges, challengeID)
+	gs.challengesMu.Unlock()
+
+	other := challenge.From
+	if player.ID == challenge.From.ID {
+		other = challenge.To
+	}
+	gs.notify(other.ID, challengeNotification(tictactoev1.NotificationType_CHALLENGE_DECLINED, challenge))
+	return challenge, nil
+}
+
+// pendingChallenges returns the challenges a player can still accept. The
+// caller must hold challengesMu.
+func (gs *GameServer) pendingChallenges(playerID string) []*game.Challenge {
+	now := time.Now()
+	var pending []*game.Challenge
+	for _, c := range gs.challenges {
+		if c.To.ID == playerID && now.Before(c.ExpiresAt) {
+			pending = append(pending, c)
+		}
+	}
+	return pending
+}
+
+func challengeNotification(t tictactoev1.NotificationType, c *game.Challenge) *tictactoev1.Notification {
+	return &tictactoev1.Notification{Type: t, Challenge: game.ChallengeToProto(c)}
+}
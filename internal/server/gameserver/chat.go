@@ -0,0 +1,120 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/chat"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrChatMessageEmpty    = errors.New("chat message is empty")
+	ErrChatMessageTooLong  = errors.New("chat message is too long")
+	ErrChatMessageRejected = errors.New("chat message was rejected")
+	ErrChatRateLimited     = errors.New("you are sending messages too fast")
+)
+
+// Messages kept in the history of a game, older ones are dropped.
+const chatHistoryLimit = 200
+
+// ChatSettings moderate the chat and reactions of every game.
+type ChatSettings struct {
+	MaxLength int           // In characters
+	Limiter   *chat.Limiter // Limits messages per player across games, nil for no limit
+	Filter    chat.Filter   // Checks messages before they are sent, nil to send them as they are
+	// Limits reactions per player across games, nil for no limit
+	ReactionLimiter *chat.Limiter
+}
+
+// SendChatMessage sends a message to the chat of a game. Messages of the
+// players go to the PLAYERS channel, everyone else's to the SPECTATORS channel.
+func (gs *GameServer) SendChatMessage(ctx context.Context, gameID string, from *game.Player, text string) (*tictactoev1.ChatMessage, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrChatMessageEmpty
+	}
+	if max := gs.chat.MaxLength; max > 0 && utf8.RuneCountInString(text) > max {
+		return nil, fmt.Errorf("%w, the limit is %d characters", ErrChatMessageTooLong, max)
+	}
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return nil, ErrGameNotFound
+	}
+	if gs.chat.Limiter != nil && !gs.chat.Limiter.Allow(from.ID) {
+		return nil, ErrChatRateLimited
+	}
+	if gs.chat.Filter != nil {
+		filtered, err := gs.chat.Filter.Filter(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrChatMessageRejected, err)
+		}
+		text = filtered
+	}
+
+	msg := &game.ChatMessage{
+		ID:      utils.GenerateUniqueID(),
+		From:    from,
+		Channel: chatChannel(gameData, from.ID),
+		Text:    text,
+		SentAt:  time.Now(),
+	}
+	gameData.Chat = append(gameData.Chat, msg)
+	if len(gameData.Chat) > chatHistoryLimit {
+		gameData.Chat = gameData.Chat[len(gameData.Chat)-chatHistoryLimit:]
+	}
+	if err := gs.storage.UpdateGame(ctx, gameData); err != nil {
+		return nil, fmt.Errorf("failed to update game: %w", err)
+	}
+
+	sent := game.ChatMessageToProto(gameID, msg)
+	gs.chatMessages.send(chatRoom(gameID, tictactoev1.ChatChannel_SPECTATORS), sent)
+	if msg.Channel == tictactoev1.ChatChannel_PLAYERS {
+		gs.chatMessages.send(chatRoom(gameID, tictactoev1.ChatChannel_PLAYERS), sent)
+	}
+	return sent, nil
+}
+
+// SubscribeChat streams the chat of a game until ctx is done, starting with
+// its history. Players only see the PLAYERS channel, spectators see both.
+func (gs *GameServer) SubscribeChat(ctx context.Context, gameID, playerID string) (<-chan *tictactoev1.ChatMessage, error) {
+	// Messages are sent while holding mu, so none are missed or repeated
+	// between the history and the stream.
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return nil, ErrGameNotFound
+	}
+	channel := chatChannel(gameData, playerID)
+	var history []*tictactoev1.ChatMessage
+	for _, msg := range gameData.Chat {
+		if channel == tictactoev1.ChatChannel_SPECTATORS || msg.Channel == tictactoev1.ChatChannel_PLAYERS {
+			history = append(history, game.ChatMessageToProto(gameID, msg))
+		}
+	}
+	return gs.chatMessages.subscribe(ctx, chatRoom(gameID, channel), history), nil
+}
+
+// chatChannel returns the channel a player chats in.
+func chatChannel(g *game.Game, playerID string) tictactoev1.ChatChannel {
+	if (g.PlayerX != nil && g.PlayerX.ID == playerID) || (g.PlayerO != nil && g.PlayerO.ID == playerID) {
+		return tictactoev1.ChatChannel_PLAYERS
+	}
+	return tictactoev1.ChatChannel_SPECTATORS
+}
+
+// chatRoom is the hub key of the streams reading a channel of a game.
+func chatRoom(gameID string, channel tictactoev1.ChatChannel) string {
+	return gameID + "/" + channel.String()
+}
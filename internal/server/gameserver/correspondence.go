@@ -0,0 +1,166 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidDaysPerMove = errors.New("days per move must be between 0 and 14")
+	ErrCorrespondenceBot  = errors.New("correspondence games are played against people")
+)
+
+const (
+	maxDaysPerMove = 14
+	// How long before the move deadline the player to move is reminded
+	moveReminder = 12 * time.Hour
+)
+
+// Scheduler jobs of a correspondence game, see gameJob
+const (
+	jobMoveDeadline = "move-deadline"
+	jobMoveReminder = "move-reminder"
+)
+
+func gameJob(gameID, job string) string {
+	return "game/" + gameID + "/" + job
+}
+
+// checkCorrespondence validates the correspondence settings of a new game.
+func checkCorrespondence(settings game.Settings) error {
+	if settings.DaysPerMove < 0 || settings.DaysPerMove > maxDaysPerMove {
+		return ErrInvalidDaysPerMove
+	}
+	if settings.DaysPerMove > 0 && settings.Bot != "" {
+		return ErrCorrespondenceBot
+	}
+	return nil
+}
+
+// resetMoveClock gives the player to move in a correspondence game their
+// days to move, or stops the clock once the game is over. It is called
+// before the game is stored.
+func (gs *GameServer) resetMoveClock(g *game.Game) {
+	if !g.Correspondence() {
+		return
+	}
+	if g.Status != tictactoev1.GameStatus_IN_PROGRESS || g.CurrentPlayer == nil {
+		g.MoveDeadline = time.Time{}
+		gs.scheduler.Cancel(gameJob(g.ID, jobMoveDeadline))
+		gs.scheduler.Cancel(gameJob(g.ID, jobMoveReminder))
+		return
+	}
+
+	id := g.ID
+	deadline := gs.scheduler.Now().Add(time.Duration(g.DaysPerMove) * 24 * time.Hour)
+	g.MoveDeadline = deadline
+	gs.scheduler.At(gameJob(id, jobMoveDeadline), deadline, func() { gs.moveTimedOut(id, deadline) })
+	gs.scheduler.At(gameJob(id, jobMoveReminder), deadline.Add(-moveReminder), func() { gs.remindMove(id, deadline) })
+}
+
+// notifyTurn tells the players of a correspondence game, who are likely not
+// watching it, that it is their turn or that the game is over.
+func (gs *GameServer) notifyTurn(g *game.Game) {
+	if !g.Correspondence() {
+		return
+	}
+	if g.Status == tictactoev1.GameStatus_IN_PROGRESS {
+		if g.CurrentPlayer != nil {
+			gs.notify(g.CurrentPlayer.ID, &tictactoev1.Notification{
+				Type:     tictactoev1.NotificationType_YOUR_TURN,
+				Game:     game.GameToProto(g),
+				Deadline: g.MoveDeadline.Unix(),
+			})
+		}
+		return
+	}
+	if g.Status == tictactoev1.GameStatus_FINISHED {
+		finished := &tictactoev1.Notification{
+			Type: tictactoev1.NotificationType_GAME_FINISHED,
+			Game: game.GameToProto(g),
+		}
+		for _, p := range []*game.Player{g.PlayerX, g.PlayerO} {
+			if p != nil {
+				gs.notify(p.ID, finished)
+			}
+		}
+	}
+}
+
+// moveTimedOut ends a correspondence game as lost by the player to move, if
+// they still haven't moved by the deadline.
+func (gs *GameServer) moveTimedOut(gameID string, deadline time.Time) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	ctx := context.Background()
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists || gameData.Status != tictactoev1.GameStatus_IN_PROGRESS || !gameData.MoveDeadline.Equal(deadline) {
+		return
+	}
+	winner := gameData.PlayerX
+	if gameData.CurrentPlayer.ID == gameData.PlayerX.ID {
+		winner = gameData.PlayerO
+	}
+	slog.Info("Correspondence game timed out", "game_id", gameID, "player_id", gameData.CurrentPlayer.ID)
+
+	gameData.Winner = winner.Name
+	gameData.Status = tictactoev1.GameStatus_FINISHED
+	gameData.Event = tictactoev1.GameEvent_TIMED_OUT
+	gameData.CurrentPlayer = nil
+	gs.resetMoveClock(gameData)
+
+	if err := gs.storage.UpdateGame(ctx, gameData); err != nil {
+		slog.Error("Failed to update timed out game", "game_id", gameID, "error", err)
+		return
+	}
+	gs.publish(gameData)
+	gs.notifyTurn(gameData)
+	if gameData.TournamentID != "" {
+		go gs.tournamentGameFinished(gameData.TournamentID, gameID, winner.ID)
+	}
+}
+
+func (gs *GameServer) remindMove(gameID string, deadline time.Time) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	gameData, exists := gs.storage.GetGame(context.Background(), gameID)
+	if !exists || gameData.Status != tictactoev1.GameStatus_IN_PROGRESS || !gameData.MoveDeadline.Equal(deadline) {
+		return
+	}
+	gs.notify(gameData.CurrentPlayer.ID, &tictactoev1.Notification{
+		Type:     tictactoev1.NotificationType_MOVE_REMINDER,
+		Game:     game.GameToProto(gameData),
+		Deadline: deadline.Unix(),
+	})
+}
+
+// MyActiveGames returns the games in progress where it is the player's turn,
+// live games first, then correspondence games by move deadline.
+func (gs *GameServer) MyActiveGames(ctx context.Context, playerID string) []*tictactoev1.GameData {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	var active []*game.Game
+	for _, g := range gs.storage.ListPlayerGames(ctx, playerID) {
+		if g.Status == tictactoev1.GameStatus_IN_PROGRESS && g.CurrentPlayer != nil && g.CurrentPlayer.ID == playerID {
+			active = append(active, g)
+		}
+	}
+	slices.SortFunc(active, func(a, b *game.Game) int {
+		return cmp.Or(a.MoveDeadline.Compare(b.MoveDeadline), a.CreatedAt.Compare(b.CreatedAt))
+	})
+
+	games := make([]*tictactoev1.GameData, len(active))
+	for i, g := range active {
+		games[i] = game.GameToProto(g)
+	}
+	return games
+}
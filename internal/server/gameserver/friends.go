@@ -0,0 +1,119 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"context"
+	"errors"
+	"slices"
+)
+
+var (
+	ErrInvalidFriendRequest  = errors.New("you can't befriend yourself")
+	ErrAlreadyFriends        = errors.New("you are already friends")
+	ErrFriendRequestSent     = errors.New("friend request already sent")
+	ErrFriendRequestNotFound = errors.New("friend request not found")
+	ErrNotFriends            = errors.New("player is not your friend")
+)
+
+// SendFriendRequest asks a player to be friends. If they had asked the sender
+// already, the players become friends right away and accepted is true.
+func (gs *GameServer) SendFriendRequest(ctx context.Context, from *game.Player, toID string) (accepted bool, err error) {
+	if toID == from.ID {
+		return false, ErrInvalidFriendRequest
+	}
+	to, exists := gs.storage.GetPlayer(ctx, toID)
+	if !exists {
+		return false, ErrPlayerNotFound
+	}
+	if gs.friends.AreFriends(ctx, from.ID, toID) {
+		return false, ErrAlreadyFriends
+	}
+	if gs.friends.DeleteFriendRequest(ctx, toID, from.ID) {
+		return true, gs.befriend(ctx, from, to)
+	}
+	if slices.Contains(gs.friends.ListFriendRequests(ctx, toID), from.ID) {
+		return false, ErrFriendRequestSent
+	}
+
+	if err := gs.friends.AddFriendRequest(ctx, from.ID, toID); err != nil {
+		return false, err
+	}
+	gs.notify(toID, &tictactoev1.Notification{
+		Type:   tictactoev1.NotificationType_FRIEND_REQUEST_RECEIVED,
+		Player: game.PlayerToProto(from),
+	})
+	return false, nil
+}
+
+// AnswerFriendRequest accepts or declines the friend request of a player.
+// Declining doesn't notify the sender.
+func (gs *GameServer) AnswerFriendRequest(ctx context.Context, player *game.Player, fromID string, accept bool) error {
+	if !gs.friends.DeleteFriendRequest(ctx, fromID, player.ID) {
+		return ErrFriendRequestNotFound
+	}
+	if !accept {
+		return nil
+	}
+	from, exists := gs.storage.GetPlayer(ctx, fromID)
+	if !exists {
+		return ErrPlayerNotFound
+	}
+	return gs.befriend(ctx, player, from)
+}
+
+// ListFriendRequests returns the players waiting for an answer from playerID.
+func (gs *GameServer) ListFriendRequests(ctx context.Context, playerID string) []*game.Player {
+	return gs.players(ctx, gs.friends.ListFriendRequests(ctx, playerID))
+}
+
+// RemoveFriend ends a friendship. Both players' presence streams are told.
+func (gs *GameServer) RemoveFriend(ctx context.Context, player *game.Player, friendID string) error {
+	if !gs.friends.RemoveFriendship(ctx, player.ID, friendID) {
+		return ErrNotFriends
+	}
+	gs.presenceUpdates.send(player.ID, &tictactoev1.Friend{
+		Player:  &tictactoev1.PlayerData{PlayerId: friendID},
+		Removed: true,
+	})
+	gs.presenceUpdates.send(friendID, &tictactoev1.Friend{
+		Player:  game.PlayerToProto(player),
+		Removed: true,
+	})
+	return nil
+}
+
+// ListFriends returns the friends of a player with their presence.
+func (gs *GameServer) ListFriends(ctx context.Context, playerID string) []*tictactoev1.Friend {
+	var friends []*tictactoev1.Friend
+	for _, p := range gs.players(ctx, gs.friends.ListFriends(ctx, playerID)) {
+		friends = append(friends, gs.friendPresence(p))
+	}
+	return friends
+}
+
+// befriend makes two players friends after accepter accepted the request of
+// requester.
+func (gs *GameServer) befriend(ctx context.Context, accepter, requester *game.Player) error {
+	if err := gs.friends.AddFriendship(ctx, accepter.ID, requester.ID); err != nil {
+		return err
+	}
+	gs.notify(requester.ID, &tictactoev1.Notification{
+		Type:   tictactoev1.NotificationType_FRIEND_REQUEST_ACCEPTED,
+		Player: game.PlayerToProto(accepter),
+	})
+	gs.presenceUpdates.send(requester.ID, gs.friendPresence(accepter))
+	gs.presenceUpdates.send(accepter.ID, gs.friendPresence(requester))
+	return nil
+}
+
+// players looks up players by ID, skipping unknown ones.
+func (gs *GameServer) players(ctx context.Context, ids []string) []*game.Player {
+	players := make([]*game.Player, 0, len(ids))
+	for _, id := range ids {
+		if p, exists := gs.storage.GetPlayer(ctx, id); exists {
+			players = append(players, p)
+		}
+	}
+	return players
+}
@@ -0,0 +1,67 @@
+package gameserver
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+)
+
+// Messages a stream hasn't read yet, newer ones are dropped.
+const streamBuffer = 16
+
+// hub fans messages out to the open streams of each subscriber, usually a
+// player.
+type hub[T any] struct {
+	name    string              // Kind of messages, for logging
+	streams map[string][]chan T // By subscriber key
+	mu      sync.Mutex
+}
+
+func newHub[T any](name string) *hub[T] {
+	return &hub[T]{name: name, streams: make(map[string][]chan T)}
+}
+
+// subscribe opens a stream for a subscriber that starts with the initial
+// messages and is closed when ctx is done.
+func (h *hub[T]) subscribe(ctx context.Context, key string, initial []T) <-chan T {
+	ch := make(chan T, len(initial)+streamBuffer)
+	for _, msg := range initial {
+		ch <- msg
+	}
+
+	h.mu.Lock()
+	h.streams[key] = append(h.streams[key], ch)
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		streams := slices.DeleteFunc(h.streams[key], func(c chan T) bool {
+			return c == ch
+		})
+		if len(streams) == 0 {
+			delete(h.streams, key)
+		} else {
+			h.streams[key] = streams
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// send delivers a message to every stream of a subscriber, and returns how
+// many streams are open.
+func (h *hub[T]) send(key string, msg T) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, ch := range h.streams[key] {
+		select {
+		case ch <- msg:
+		default:
+			slog.Warn("Message dropped, the stream is not keeping up", "stream", h.name, "key", key)
+		}
+	}
+	return len(h.streams[key])
+}
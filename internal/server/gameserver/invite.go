@@ -0,0 +1,165 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var ErrJoinCodeNotFound = errors.New("join code is invalid or has expired")
+
+const (
+	joinCodeLength = 6
+	// Letters and digits that can't be mistaken for each other, so no 0/O or 1/I/L.
+	joinCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+	joinCodeTTL      = 30 * time.Minute
+	// Wrong passwords and join codes a player may try in a row, after which
+	// they get another try every joinAttemptInterval
+	joinAttemptBurst    = 5
+	joinAttemptInterval = time.Minute
+)
+
+type joinCode struct {
+	gameID    string
+	expiresAt time.Time
+}
+
+// Invite is what the creator of a game shares with their opponent.
+type Invite struct {
+	JoinCode          string // Short code for JoinByCode
+	JoinCodeExpiresAt time.Time
+}
+
+// GetInvite returns the invite of a game for its creator to share. A new join
+// code is issued if the game has none, or its code expired or was revoked.
+func (gs *GameServer) GetInvite(ctx context.Context, gameID, playerID string) (Invite, error) {
+	if _, err := gs.creatorGame(ctx, gameID, playerID); err != nil {
+		return Invite{}, err
+	}
+	code, expiresAt, err := gs.issueJoinCode(gameID)
+	if err != nil {
+		return Invite{}, err
+	}
+	return Invite{JoinCode: code, JoinCodeExpiresAt: expiresAt}, nil
+}
+
+// RevokeJoinCode stops the join code of a game from working.
+func (gs *GameServer) RevokeJoinCode(ctx context.Context, gameID, playerID string) error {
+	if _, err := gs.creatorGame(ctx, gameID, playerID); err != nil {
+		return err
+	}
+	gs.releaseJoinCode(gameID)
+	return nil
+}
+
+// JoinByCode joins the game a join code was issued for. The code is an
+// invite from the creator, so the game's password isn't needed.
+func (gs *GameServer) JoinByCode(ctx context.Context, code string, player *game.Player) (*game.Game, error) {
+	if !gs.joinAttempts.Allow(player.ID) {
+		return nil, ErrTooManyAttempts
+	}
+	code = NormalizeJoinCode(code)
+
+	gs.joinCodesMu.Lock()
+	entry, ok := gs.joinCodes[code]
+	gs.joinCodesMu.Unlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, ErrJoinCodeNotFound
+	}
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gameData, err := gs.waitingGame(ctx, entry.gameID)
+	if err != nil {
+		return nil, err
+	}
+	return gs.seatOpponent(ctx, gameData, player)
+}
+
+// NormalizeJoinCode makes join codes case insensitive and allows separators
+// such as "ABC-123".
+func NormalizeJoinCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
+
+// creatorGame returns a game waiting for its opponent if it was created by playerID.
+func (gs *GameServer) creatorGame(ctx context.Context, gameID, playerID string) (*game.Game, error) {
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return nil, errors.New("game not found")
+	}
+	if gameData.PlayerX == nil || gameData.PlayerX.ID != playerID {
+		return nil, ErrNotGameCreator
+	}
+	if gameData.Status != tictactoev1.GameStatus_WAITING_FOR_PLAYER {
+		return nil, errors.New("game has already started / finished")
+	}
+	return gameData, nil
+}
+
+func (gs *GameServer) issueJoinCode(gameID string) (string, time.Time, error) {
+	gs.joinCodesMu.Lock()
+	defer gs.joinCodesMu.Unlock()
+
+	now := time.Now()
+	if code, ok := gs.gameCodes[gameID]; ok {
+		if entry := gs.joinCodes[code]; now.Before(entry.expiresAt) {
+			return code, entry.expiresAt, nil
+		}
+	}
+
+	// Drop expired codes so they can be issued again.
+	for code, entry := range gs.joinCodes {
+		if now.After(entry.expiresAt) {
+			delete(gs.joinCodes, code)
+			delete(gs.gameCodes, entry.gameID)
+		}
+	}
+
+	for {
+		code, err := randomJoinCode()
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if _, taken := gs.joinCodes[code]; taken {
+			continue
+		}
+		entry := joinCode{gameID: gameID, expiresAt: now.Add(joinCodeTTL)}
+		gs.joinCodes[code] = entry
+		gs.gameCodes[gameID] = code
+		return code, entry.expiresAt, nil
+	}
+}
+
+// releaseJoinCode forgets the join code of a game once it can't be joined.
+func (gs *GameServer) releaseJoinCode(gameID string) {
+	gs.joinCodesMu.Lock()
+	defer gs.joinCodesMu.Unlock()
+	if code, ok := gs.gameCodes[gameID]; ok {
+		delete(gs.joinCodes, code)
+		delete(gs.gameCodes, gameID)
+	}
+}
+
+func randomJoinCode() (string, error) {
+	code := make([]byte, joinCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(joinCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = joinCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
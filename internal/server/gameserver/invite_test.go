@@ -0,0 +1,87 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"context"
+	"errors"
+	"testing"
+)
+
+func newPasswordGame(t *testing.T, gs *GameServer) *game.Game {
+	t.Helper()
+	g, err := gs.CreateGame(context.Background(), login(t, gs, "creator"), game.Settings{Size: 3, WinLength: 3, Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestJoinByCodeNeedsNoPassword(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+	g := newPasswordGame(t, gs)
+
+	invite, err := gs.GetInvite(ctx, g.ID, g.PlayerX.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	joined, err := gs.JoinByCode(ctx, invite.JoinCode, login(t, gs, "friend"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if joined.Status != tictactoev1.GameStatus_IN_PROGRESS {
+		t.Errorf("status = %v, want IN_PROGRESS", joined.Status)
+	}
+	if _, err := gs.JoinByCode(ctx, invite.JoinCode, login(t, gs, "late")); !errors.Is(err, ErrJoinCodeNotFound) {
+		t.Errorf("join code still works after the game started: %v", err)
+	}
+}
+
+func TestJoinAttemptsAreLimited(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+	g := newPasswordGame(t, gs)
+	guesser := login(t, gs, "guesser")
+
+	for i := 0; i < joinAttemptBurst; i++ {
+		if _, err := gs.JoinGame(ctx, g.ID, guesser, "wrong"); !errors.Is(err, ErrIncorrectPassword) {
+			t.Fatalf("attempt %d: got %v, want ErrIncorrectPassword", i+1, err)
+		}
+	}
+	if _, err := gs.JoinGame(ctx, g.ID, guesser, "secret"); !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("got %v, want ErrTooManyAttempts", err)
+	}
+	if _, err := gs.JoinByCode(ctx, "ABCDEF", guesser); !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("join codes aren't limited: %v", err)
+	}
+
+	// Other players are not affected
+	if _, err := gs.JoinGame(ctx, g.ID, login(t, gs, "friend"), "secret"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJoinByCodeKeepsStream(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+	g := newPasswordGame(t, gs)
+
+	invite, err := gs.GetInvite(ctx, g.ID, g.PlayerX.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Invite links open the game in the browser client, which follows it
+	// before joining with the code
+	friend := login(t, gs, "friend")
+	updates, _, err := gs.Subscribe(ctx, g.ID, friend.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.JoinByCode(ctx, invite.JoinCode, friend); err != nil {
+		t.Fatal(err)
+	}
+	if update := nextUpdate(t, updates); update.Event != tictactoev1.GameEvent_PLAYER_JOINED {
+		t.Errorf("event = %v, want PLAYER_JOINED", update.Event)
+	}
+}
@@ -0,0 +1,42 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// How long the notifier gets to deliver a notification
+const notifierTimeout = 30 * time.Second
+
+// SubscribeNotifications streams the notifications of a player until ctx is
+// done. Challenges the player received earlier and can still accept are
+// delivered first, so none are missed between reconnects.
+func (gs *GameServer) SubscribeNotifications(ctx context.Context, playerID string) <-chan *tictactoev1.Notification {
+	// Challenges are sent while holding challengesMu, so taking it here
+	// makes sure a new challenge is either pending or notified, not both.
+	gs.challengesMu.Lock()
+	defer gs.challengesMu.Unlock()
+
+	var pending []*tictactoev1.Notification
+	for _, c := range gs.pendingChallenges(playerID) {
+		pending = append(pending, challengeNotification(tictactoev1.NotificationType_CHALLENGE_RECEIVED, c))
+	}
+	return gs.notifications.subscribe(ctx, playerID, pending)
+}
+
+// notify sends a notification to every stream of a player, or through the
+// notifier when the player has none open.
+func (gs *GameServer) notify(playerID string, n *tictactoev1.Notification) {
+	if gs.notifications.send(playerID, n) > 0 || gs.notifier == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifierTimeout)
+		defer cancel()
+		if err := gs.notifier.Notify(ctx, playerID, n); err != nil {
+			slog.Warn("Failed to notify offline player", "player_id", playerID, "type", n.Type, "error", err)
+		}
+	}()
+}
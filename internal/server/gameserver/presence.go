@@ -0,0 +1,121 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"context"
+	"time"
+)
+
+const (
+	// HeartbeatInterval is how often clients should call Heartbeat.
+	HeartbeatInterval = 15 * time.Second
+	// Players go offline once a heartbeat is this late, which allows one
+	// heartbeat to get lost.
+	presenceTimeout = 2*HeartbeatInterval + 5*time.Second
+)
+
+// presenceState is what the presence of a player is derived from.
+type presenceState struct {
+	lastHeartbeat time.Time
+	gameStreams   int         // Open streams of game updates
+	expiry        *time.Timer // Takes the player offline when heartbeats stop
+	// Presence friends were last told about. It can't be derived again once
+	// a heartbeat is late, which is exactly when expiry fires.
+	reported tictactoev1.Presence
+}
+
+func (p *presenceState) presence() tictactoev1.Presence {
+	switch {
+	case p.gameStreams > 0:
+		return tictactoev1.Presence_IN_GAME
+	case time.Since(p.lastHeartbeat) < presenceTimeout:
+		return tictactoev1.Presence_ONLINE
+	default:
+		return tictactoev1.Presence_OFFLINE
+	}
+}
+
+// Heartbeat keeps a player online until presenceTimeout passes without
+// another heartbeat.
+func (gs *GameServer) Heartbeat(playerID string) {
+	gs.updatePresence(playerID, func(p *presenceState) {
+		p.lastHeartbeat = time.Now()
+		if p.expiry != nil {
+			p.expiry.Stop()
+		}
+		p.expiry = time.AfterFunc(presenceTimeout, func() {
+			gs.updatePresence(playerID, func(*presenceState) {})
+		})
+	})
+}
+
+// trackGameStream counts a player as in a game until ctx is done.
+func (gs *GameServer) trackGameStream(ctx context.Context, playerID string) {
+	gs.updatePresence(playerID, func(p *presenceState) { p.gameStreams++ })
+	go func() {
+		<-ctx.Done()
+		gs.updatePresence(playerID, func(p *presenceState) { p.gameStreams-- })
+	}()
+}
+
+// SubscribePresence streams presence changes of a player's friends until ctx
+// is done, starting with the presence of every friend.
+func (gs *GameServer) SubscribePresence(ctx context.Context, playerID string) <-chan *tictactoev1.Friend {
+	// Changes are sent while holding presenceMu, so none are missed or
+	// delivered before the current state.
+	gs.presenceMu.Lock()
+	defer gs.presenceMu.Unlock()
+
+	var friends []*tictactoev1.Friend
+	for _, p := range gs.players(ctx, gs.friends.ListFriends(ctx, playerID)) {
+		friends = append(friends, gs.friendPresenceLocked(p))
+	}
+	return gs.presenceUpdates.subscribe(ctx, playerID, friends)
+}
+
+func (gs *GameServer) friendPresence(p *game.Player) *tictactoev1.Friend {
+	gs.presenceMu.Lock()
+	defer gs.presenceMu.Unlock()
+	return gs.friendPresenceLocked(p)
+}
+
+func (gs *GameServer) friendPresenceLocked(p *game.Player) *tictactoev1.Friend {
+	presence := tictactoev1.Presence_OFFLINE
+	if state, ok := gs.presence[p.ID]; ok {
+		presence = state.reported
+	}
+	return &tictactoev1.Friend{Player: game.PlayerToProto(p), Presence: presence}
+}
+
+// updatePresence changes what the presence of a player is derived from, and
+// tells their friends if the presence changed.
+func (gs *GameServer) updatePresence(playerID string, update func(*presenceState)) {
+	gs.presenceMu.Lock()
+	defer gs.presenceMu.Unlock()
+
+	state, ok := gs.presence[playerID]
+	if !ok {
+		state = &presenceState{}
+		gs.presence[playerID] = state
+	}
+	before := state.reported
+	update(state)
+	after := state.presence()
+	state.reported = after
+	if after == tictactoev1.Presence_OFFLINE {
+		delete(gs.presence, playerID)
+	}
+	if before == after {
+		return
+	}
+
+	player, exists := gs.storage.GetPlayer(context.Background(), playerID)
+	if !exists {
+		return
+	}
+	change := &tictactoev1.Friend{Player: game.PlayerToProto(player), Presence: after}
+	for _, friendID := range gs.friends.ListFriends(context.Background(), playerID) {
+		gs.presenceUpdates.send(friendID, change)
+	}
+}
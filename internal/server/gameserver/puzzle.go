@@ -0,0 +1,126 @@
+package gameserver
+
+import (
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/puzzle"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+var (
+	ErrNoPuzzles         = errors.New("no puzzles available")
+	ErrPuzzleNotServed   = errors.New("puzzle was not served to this player")
+	ErrInvalidPuzzleMove = errors.New("invalid move for this puzzle")
+)
+
+// Puzzles are picked at random among this many closest to the player's rating.
+const puzzleChoices = 8
+
+// PuzzleAttempt is the outcome of a submitted puzzle solution.
+type PuzzleAttempt struct {
+	Correct      bool
+	Solution     int
+	Rating       int
+	RatingChange int
+}
+
+// NextPuzzle serves a puzzle close to the player's rating, or the puzzle they
+// were already served and have not solved yet. It also returns the player's rating.
+func (gs *GameServer) NextPuzzle(ctx context.Context, player *game.Player) (puzzle.Puzzle, int, error) {
+	gs.puzzlesMu.Lock()
+	defer gs.puzzlesMu.Unlock()
+
+	rating := gs.puzzleRating(ctx, player.ID)
+	if id, ok := gs.pending[player.ID]; ok {
+		if p, exists := gs.puzzles.GetPuzzle(ctx, id); exists {
+			return *p, rating, nil
+		}
+	}
+
+	all := gs.puzzles.ListPuzzles(ctx)
+	if len(all) == 0 {
+		return puzzle.Puzzle{}, 0, ErrNoPuzzles
+	}
+
+	attempted := gs.attempted[player.ID]
+	candidates := make([]*puzzle.Puzzle, 0, len(all))
+	for _, p := range all {
+		if !attempted[p.ID] {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) == 0 {
+		// Every puzzle has been seen, start over.
+		delete(gs.attempted, player.ID)
+		candidates = all
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return abs(candidates[i].Rating-rating) < abs(candidates[j].Rating-rating)
+	})
+	p := candidates[rand.Intn(min(puzzleChoices, len(candidates)))]
+	gs.pending[player.ID] = p.ID
+
+	return *p, rating, nil
+}
+
+// SubmitPuzzleSolution checks the first move of a served puzzle and updates the
+// player's and the puzzle's ratings.
+func (gs *GameServer) SubmitPuzzleSolution(ctx context.Context, player *game.Player, puzzleID string, position int) (PuzzleAttempt, error) {
+	gs.puzzlesMu.Lock()
+	defer gs.puzzlesMu.Unlock()
+
+	if gs.pending[player.ID] != puzzleID {
+		return PuzzleAttempt{}, ErrPuzzleNotServed
+	}
+	stored, exists := gs.puzzles.GetPuzzle(ctx, puzzleID)
+	if !exists {
+		return PuzzleAttempt{}, ErrPuzzleNotServed
+	}
+	if position < 0 || position >= len(stored.Board) || stored.Board[position] != "" {
+		return PuzzleAttempt{}, ErrInvalidPuzzleMove
+	}
+
+	correct := position == stored.Solution
+	rating := gs.puzzleRating(ctx, player.ID)
+	newRating, puzzleRating := puzzle.Rate(rating, stored.Rating, correct)
+
+	updated := *stored
+	updated.Rating = puzzleRating
+	if err := gs.puzzles.UpdatePuzzle(ctx, &updated); err != nil {
+		return PuzzleAttempt{}, fmt.Errorf("failed to update puzzle: %w", err)
+	}
+	if err := gs.puzzles.SetRating(ctx, player.ID, newRating); err != nil {
+		return PuzzleAttempt{}, fmt.Errorf("failed to update rating: %w", err)
+	}
+
+	delete(gs.pending, player.ID)
+	if gs.attempted[player.ID] == nil {
+		gs.attempted[player.ID] = make(map[string]bool)
+	}
+	gs.attempted[player.ID][puzzleID] = true
+
+	return PuzzleAttempt{
+		Correct:      correct,
+		Solution:     stored.Solution,
+		Rating:       newRating,
+		RatingChange: newRating - rating,
+	}, nil
+}
+
+func (gs *GameServer) puzzleRating(ctx context.Context, playerID string) int {
+	if rating, exists := gs.puzzles.GetRating(ctx, playerID); exists {
+		return rating
+	}
+	return puzzle.DefaultRating
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
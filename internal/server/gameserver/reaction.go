@@ -0,0 +1,55 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"context"
+	"errors"
+	"log/slog"
+)
+
+var (
+	ErrInvalidReaction = errors.New("unknown reaction")
+	ErrNotPlaying      = errors.New("only players of the game can react")
+	ErrGameOver        = errors.New("the game is over")
+)
+
+// SendReaction shows a reaction of a player to everyone following the game.
+// It is broadcast as an update with the REACTION event, which leaves the
+// event of the game as it was. Reactions are dropped rather than delaying
+// the moves queued for broadcasting.
+func (gs *GameServer) SendReaction(ctx context.Context, gameID string, from *game.Player, reaction tictactoev1.ReactionType) error {
+	if _, ok := tictactoev1.ReactionType_name[int32(reaction)]; !ok {
+		return ErrInvalidReaction
+	}
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return ErrGameNotFound
+	}
+	if chatChannel(gameData, from.ID) != tictactoev1.ChatChannel_PLAYERS {
+		return ErrNotPlaying
+	}
+	// The updates of a finished game are no longer broadcast
+	if gameData.Status == tictactoev1.GameStatus_FINISHED {
+		return ErrGameOver
+	}
+	if gs.chat.ReactionLimiter != nil && !gs.chat.ReactionLimiter.Allow(from.ID) {
+		return ErrChatRateLimited
+	}
+
+	update := game.GameToProto(gameData)
+	update.Version++
+	update.Event = tictactoev1.GameEvent_REACTION
+	update.Reaction = &tictactoev1.Reaction{Type: reaction, From: game.PlayerToProto(from)}
+	select {
+	case gameData.Updates <- update:
+		gameData.Version++
+	default:
+		slog.Warn("Reaction dropped, the game's updates are not keeping up", "game_id", gameID)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestReactionToFinishedGame(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+	x, o := login(t, gs, "x"), login(t, gs, "o")
+	g, err := gs.CreateGame(ctx, x, game.Settings{Size: 3, WinLength: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.JoinGame(ctx, g.ID, o, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := gs.SendReaction(ctx, g.ID, o, tictactoev1.ReactionType_GOOD_MOVE); err != nil {
+		t.Fatal(err)
+	}
+	// X wins along the top row, which closes the game's updates
+	for i, cell := range []int32{0, 3, 1, 4, 2} {
+		player := x
+		if i%2 == 1 {
+			player = o
+		}
+		if _, err := gs.MakeMove(ctx, g.ID, player, cell); err != nil {
+			t.Fatal(err)
+		}
+	}
+	eventually(t, "the last update is broadcast", func() bool {
+		gs.mu.RLock()
+		defer gs.mu.RUnlock()
+		return len(g.Updates) == 0
+	})
+
+	if err := gs.SendReaction(ctx, g.ID, o, tictactoev1.ReactionType_GOOD_MOVE); !errors.Is(err, ErrGameOver) {
+		t.Fatalf("got %v, want ErrGameOver", err)
+	}
+}
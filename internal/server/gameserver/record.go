@@ -0,0 +1,76 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/notation"
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrGameNotFinished = errors.New("game is not finished yet")
+	ErrInvalidRecord   = errors.New("invalid game record")
+)
+
+// ExportGame writes a finished game in record notation.
+func (gs *GameServer) ExportGame(ctx context.Context, gameID string) (string, error) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return "", errors.New("game not found")
+	}
+	if gameData.Status != tictactoev1.GameStatus_FINISHED {
+		return "", ErrGameNotFinished
+	}
+
+	return notation.Encode(notation.FromGame(gameData)), nil
+}
+
+// ImportGame replays a game record through the rules and returns the result
+// as a finished game. Imports aren't stored, so the game has no ID and
+// players without IDs.
+func (gs *GameServer) ImportGame(ctx context.Context, text string) (*game.Game, error) {
+	record, err := notation.Decode(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecord, err)
+	}
+	if _, _, err := boardShape(record.Size, record.WinLength); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecord, err)
+	}
+	board, err := record.Replay()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecord, err)
+	}
+
+	imported := &game.Game{
+		PlayerX:       &game.Player{Name: record.X},
+		PlayerO:       &game.Player{Name: record.O},
+		Board:         board,
+		Status:        tictactoev1.GameStatus_FINISHED,
+		Event:         tictactoev1.GameEvent_GAME_OVER,
+		Moves:         make([]int32, len(record.Moves)),
+		Size:          record.Size,
+		WinLength:     record.WinLength,
+		CreatedAt:     record.Date,
+		AllowHints:    true,
+		StartPosition: record.Start,
+		DaysPerMove:   record.DaysPerMove,
+	}
+	for i, m := range record.Moves {
+		imported.Moves[i] = int32(m)
+	}
+	switch record.Result {
+	case notation.ResultXWins:
+		imported.Winner = record.X
+	case notation.ResultOWins:
+		imported.Winner = record.O
+	}
+	if record.Termination == notation.TerminationAbandoned {
+		imported.Event = tictactoev1.GameEvent_PLAYER_LEAVED
+	}
+	return imported, nil
+}
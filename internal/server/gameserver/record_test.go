@@ -0,0 +1,27 @@
+package gameserver
+
+import (
+	"context"
+	"testing"
+)
+
+const wonRecord = `[X "alice"]
+[O "bob"]
+[Result "1-0"]
+
+1. b2 a1 2. c3 a3 3. a2 b1 4. c2 1-0`
+
+func TestImportIsNotStored(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+
+	imported, err := gs.ImportGame(context.Background(), wonRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.Winner != "alice" || len(imported.Moves) != 7 {
+		t.Errorf("imported winner %q after %d moves, want alice after 7", imported.Winner, len(imported.Moves))
+	}
+	if games := gs.storage.ListPlayerGames(context.Background(), ""); len(games) != 0 {
+		t.Errorf("import stored %d games of players without IDs", len(games))
+	}
+}
@@ -0,0 +1,56 @@
+package gameserver
+
+import (
+	"TicTacToe/internal/render"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var ErrInvalidRenderOptions = errors.New("invalid render options")
+
+const (
+	defaultMoveDelay = 800 * time.Millisecond
+	maxMoveDelay     = 10 * time.Second
+)
+
+// RenderGame draws the current board of a game as a PNG, or the whole game
+// as an animated GIF.
+func (gs *GameServer) RenderGame(ctx context.Context, gameID string, animated bool, cellSize int, moveDelay time.Duration) ([]byte, error) {
+	if moveDelay == 0 {
+		moveDelay = defaultMoveDelay
+	}
+	if moveDelay < 0 || moveDelay > maxMoveDelay {
+		return nil, fmt.Errorf("%w: move delay must be at most %v", ErrInvalidRenderOptions, maxMoveDelay)
+	}
+	renderer, err := render.New(cellSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRenderOptions, err)
+	}
+
+	// Render a snapshot so moves made meanwhile do not tear the image.
+	gs.mu.RLock()
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		gs.mu.RUnlock()
+		return nil, errors.New("game not found")
+	}
+	snapshot := *gameData
+	snapshot.Board = slices.Clone(gameData.Board)
+	snapshot.Moves = slices.Clone(gameData.Moves)
+	gs.mu.RUnlock()
+
+	var buf bytes.Buffer
+	if animated {
+		err = renderer.GIF(&buf, &snapshot, moveDelay)
+	} else {
+		err = renderer.PNG(&buf, &snapshot)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render game: %w", err)
+	}
+	return buf.Bytes(), nil
+}
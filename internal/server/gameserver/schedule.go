@@ -0,0 +1,191 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/tournament"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// How long before a scheduled start the registered players are reminded
+const startReminder = 10 * time.Minute
+
+// Scheduler jobs of a tournament, see tournamentJob
+const (
+	jobStart          = "start"
+	jobStartReminder  = "start-reminder"
+	jobForfeitWarning = "forfeit-warning"
+	jobNoShows        = "no-shows"
+)
+
+func tournamentJob(tournamentID, job string) string {
+	return "tournament/" + tournamentID + "/" + job
+}
+
+// scheduleStart starts a tournament at its scheduled start, and reminds the
+// registered players shortly before.
+func (gs *GameServer) scheduleStart(t *tournament.Tournament) {
+	startsAt := t.Schedule.StartsAt
+	if startsAt.IsZero() {
+		return
+	}
+	id := t.ID
+	gs.scheduler.At(tournamentJob(id, jobStart), startsAt, func() { gs.startScheduled(id) })
+	if remindAt := startsAt.Add(-startReminder); remindAt.After(gs.scheduler.Now()) {
+		gs.scheduler.At(tournamentJob(id, jobStartReminder), remindAt, func() { gs.remindStart(id) })
+	}
+}
+
+// unschedule drops the pending jobs of a tournament.
+func (gs *GameServer) unschedule(tournamentID string) {
+	for _, job := range []string{jobStart, jobStartReminder, jobForfeitWarning, jobNoShows} {
+		gs.scheduler.Cancel(tournamentJob(tournamentID, job))
+	}
+	gs.seenMu.Lock()
+	delete(gs.seen, tournamentID)
+	gs.seenMu.Unlock()
+}
+
+func (gs *GameServer) startScheduled(tournamentID string) {
+	ctx := context.Background()
+	_, err := gs.updateTournament(ctx, tournamentID, func(t *tournament.Tournament) error {
+		// The creator may have started it already
+		if t.Status != tictactoev1.TournamentStatus_REGISTRATION {
+			return nil
+		}
+		return gs.startTournament(ctx, t)
+	})
+	if err != nil {
+		slog.Warn("Scheduled tournament did not start", "tournament_id", tournamentID, "error", err)
+	}
+}
+
+func (gs *GameServer) remindStart(tournamentID string) {
+	gs.tournamentsMu.Lock()
+	defer gs.tournamentsMu.Unlock()
+
+	t, exists := gs.tournaments.GetTournament(context.Background(), tournamentID)
+	if !exists || t.Status != tictactoev1.TournamentStatus_REGISTRATION {
+		return
+	}
+	for _, p := range t.Players {
+		gs.notify(p.ID, &tictactoev1.Notification{
+			Type:         tictactoev1.NotificationType_TOURNAMENT_STARTING,
+			TournamentId: t.ID,
+			Deadline:     t.Schedule.StartsAt.Unix(),
+		})
+	}
+}
+
+// roundStarted is called with tournamentsMu held when a tournament pairs a
+// round at now. Players who haven't connected to their game are warned
+// halfway to the no-show deadline, and forfeit at the deadline.
+func (gs *GameServer) roundStarted(t *tournament.Tournament, now time.Time) {
+	gs.scheduler.Cancel(tournamentJob(t.ID, jobStart))
+	gs.scheduler.Cancel(tournamentJob(t.ID, jobStartReminder))
+
+	t.RoundStartedAt = now
+	timeout := t.Schedule.NoShowTimeout
+	if timeout == 0 {
+		return
+	}
+	id, round := t.ID, t.Round
+	deadline := t.RoundStartedAt.Add(timeout)
+	gs.scheduler.At(tournamentJob(id, jobForfeitWarning), t.RoundStartedAt.Add(timeout/2), func() {
+		gs.warnNoShows(id, round, deadline)
+	})
+	gs.scheduler.At(tournamentJob(id, jobNoShows), deadline, func() {
+		gs.forfeitNoShows(id, round)
+	})
+}
+
+func (gs *GameServer) warnNoShows(tournamentID string, round int, deadline time.Time) {
+	gs.tournamentsMu.Lock()
+	defer gs.tournamentsMu.Unlock()
+
+	ctx := context.Background()
+	t, exists := gs.tournaments.GetTournament(ctx, tournamentID)
+	if !exists || t.Status != tictactoev1.TournamentStatus_RUNNING || t.Round != round {
+		return
+	}
+	for _, m := range t.RoundMatches() {
+		if m.Finished {
+			continue
+		}
+		var current *tictactoev1.GameData
+		if g, ok := gs.storage.GetGame(ctx, m.Games[len(m.Games)-1]); ok {
+			current = game.GameToProto(g)
+		}
+		for _, playerID := range gs.noShows(t, m) {
+			gs.notify(playerID, &tictactoev1.Notification{
+				Type:         tictactoev1.NotificationType_TOURNAMENT_FORFEIT_WARNING,
+				Game:         current,
+				TournamentId: t.ID,
+				Deadline:     deadline.Unix(),
+			})
+		}
+	}
+}
+
+// forfeitNoShows ends the matches of the round in which a player still
+// hasn't connected, and removes the no-shows from their games. It takes gs.mu
+// inside tournamentsMu, which nothing holding gs.mu may take in turn.
+func (gs *GameServer) forfeitNoShows(tournamentID string, round int) {
+	ctx := context.Background()
+	_, err := gs.updateTournament(ctx, tournamentID, func(t *tournament.Tournament) error {
+		if t.Status != tictactoev1.TournamentStatus_RUNNING || t.Round != round {
+			return nil
+		}
+		for _, m := range t.RoundMatches() {
+			if m.Finished {
+				continue
+			}
+			noShows := gs.noShows(t, m)
+			if len(noShows) == 0 {
+				continue
+			}
+			gameID := m.Games[len(m.Games)-1]
+			next := t.Forfeit(m, noShows...)
+			slog.Info("Tournament match forfeited", "tournament_id", t.ID, "game_id", gameID, "no_shows", noShows)
+			// The match is over, so the result of the game left isn't recorded
+			for _, playerID := range noShows {
+				if _, err := gs.LeaveGame(ctx, gameID, playerID); err != nil {
+					slog.Warn("Failed to remove no-show from game", "game_id", gameID, "player_id", playerID, "error", err)
+				}
+			}
+			gs.startMatches(ctx, t, next)
+		}
+		return nil
+	})
+	if err != nil {
+		slog.Error("Failed to forfeit no-shows", "tournament_id", tournamentID, "error", err)
+	}
+}
+
+// noShows returns the players of a match who haven't connected to a game or
+// moved since the round started.
+func (gs *GameServer) noShows(t *tournament.Tournament, m *tournament.Match) []string {
+	gs.seenMu.Lock()
+	defer gs.seenMu.Unlock()
+
+	var noShows []string
+	for _, p := range []*game.Player{m.X, m.O} {
+		if seen, ok := gs.seen[t.ID][p.ID]; !ok || seen.Before(t.RoundStartedAt) {
+			noShows = append(noShows, p.ID)
+		}
+	}
+	return noShows
+}
+
+// markSeen records that a player connected to a game of a tournament.
+func (gs *GameServer) markSeen(tournamentID, playerID string) {
+	gs.seenMu.Lock()
+	defer gs.seenMu.Unlock()
+
+	if gs.seen[tournamentID] == nil {
+		gs.seen[tournamentID] = make(map[string]time.Time)
+	}
+	gs.seen[tournamentID][playerID] = gs.scheduler.Now()
+}
@@ -0,0 +1,88 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/tournament"
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// received returns the types of the notifications waiting in a stream.
+func received(notifications <-chan *tictactoev1.Notification) []tictactoev1.NotificationType {
+	var types []tictactoev1.NotificationType
+	for {
+		select {
+		case n := <-notifications:
+			types = append(types, n.Type)
+		default:
+			return types
+		}
+	}
+}
+
+func TestNoShowForfeits(t *testing.T) {
+	gs, clk := newTestServer(t, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	present, absent := login(t, gs, "present"), login(t, gs, "absent")
+	const timeout = 10 * time.Minute
+	tr, err := gs.CreateTournament(ctx, present, "", tictactoev1.TournamentFormat_KNOCKOUT, game.Settings{Size: 3, WinLength: 3}, 1, 0, tournament.Schedule{NoShowTimeout: timeout})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []*game.Player{present, absent} {
+		if _, err := gs.RegisterForTournament(ctx, p, tr.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	notifications := gs.SubscribeNotifications(ctx, absent.ID)
+	if _, err := gs.StartTournament(ctx, present, tr.ID); err != nil {
+		t.Fatal(err)
+	}
+	match := tr.RoundMatches()[0]
+	gameID := match.Games[0]
+	updates, _, err := gs.Subscribe(ctx, gameID, present.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := received(notifications); !slices.Equal(got, []tictactoev1.NotificationType{tictactoev1.NotificationType_TOURNAMENT_GAME_READY}) {
+		t.Fatalf("notifications at the start = %v", got)
+	}
+
+	clk.Advance(timeout / 2)
+	if got := received(notifications); !slices.Equal(got, []tictactoev1.NotificationType{tictactoev1.NotificationType_TOURNAMENT_FORFEIT_WARNING}) {
+		t.Errorf("notifications halfway = %v, want a forfeit warning", got)
+	}
+	if match.Finished {
+		t.Fatal("match forfeited before the deadline")
+	}
+
+	clk.Advance(timeout / 2)
+	gs.tournamentsMu.Lock()
+	finished, winner := match.Finished, match.Winner
+	gs.tournamentsMu.Unlock()
+	if !finished || winner == nil || winner.ID != present.ID {
+		t.Fatalf("match finished = %v, winner = %v, want a win for the player who showed up", finished, winner)
+	}
+
+	if last := lastUpdate(t, updates); last.Event != tictactoev1.GameEvent_PLAYER_LEAVED {
+		t.Errorf("last update of the player who showed up = %v, want PLAYER_LEAVED", last.Event)
+	}
+
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	g, exists := gs.GetGame(gameID)
+	if !exists {
+		t.Fatal("game of the player who showed up was deleted")
+	}
+	if g.Status != tictactoev1.GameStatus_FINISHED || g.Event != tictactoev1.GameEvent_PLAYER_LEAVED {
+		t.Errorf("game status = %v, event = %v, want the no-show to have left", g.Status, g.Event)
+	}
+	if g.PlayerO != nil && g.PlayerO.ID == absent.ID || g.PlayerX != nil && g.PlayerX.ID == absent.ID {
+		t.Error("no-show is still seated")
+	}
+}
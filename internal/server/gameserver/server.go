@@ -2,7 +2,13 @@ package gameserver
 
 import (
 	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/chat"
+	"TicTacToe/internal/clock"
 	"TicTacToe/internal/game"
+	"TicTacToe/internal/notifier"
+	"TicTacToe/internal/scheduler"
+	"TicTacToe/internal/solver"
 	"TicTacToe/internal/storage"
 	"TicTacToe/internal/utils"
 	"context"
@@ -10,17 +16,80 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
+)
+
+var (
+	ErrGameNotFound      = errors.New("game not found")
+	ErrHintsDisabled     = errors.New("hints are disabled for this game")
+	ErrNotInGame         = errors.New("you are not playing in this game")
+	ErrIncorrectPassword = errors.New("incorrect password")
+	ErrTooManyAttempts   = errors.New("too many attempts to join, try again later")
+	ErrNotGameCreator    = errors.New("only the creator of the game can invite players")
 )
 
 type GameServer struct {
-	storage storage.GameStorage
-	mu      sync.RWMutex
+	storage          storage.GameStorage
+	solver           *solver.Solver
+	bots             *bot.Registry
+	engines          map[string]bot.Engine // Bot opponents by game ID
+	watchers         map[string]int        // Open streams of the player of each bot game, by game ID
+	enginesMu        sync.Mutex
+	botMoveTime      time.Duration
+	puzzles          storage.PuzzleStorage
+	pending          map[string]string          // Puzzle served to each player, by player ID
+	attempted        map[string]map[string]bool // Puzzle IDs each player has attempted
+	puzzlesMu        sync.Mutex
+	joinCodes        map[string]joinCode // Games by join code
+	gameCodes        map[string]string   // Join code of each game, by game ID
+	joinCodesMu      sync.Mutex
+	joinAttempts     *chat.Limiter              // Password and join code guesses per player
+	challenges       map[string]*game.Challenge // Challenges waiting for an answer, by ID
+	challengesMu     sync.Mutex
+	notifications    *hub[*tictactoev1.Notification]
+	friends          storage.FriendStorage
+	presence         map[string]*presenceState // Players who are not offline, by ID
+	presenceMu       sync.Mutex
+	presenceUpdates  *hub[*tictactoev1.Friend]
+	chat             ChatSettings
+	chatMessages     *hub[*tictactoev1.ChatMessage] // By game ID and channel, see chatRoom
+	tournaments      storage.TournamentStorage
+	tournamentsMu    sync.Mutex
+	standingsUpdates *hub[*tictactoev1.Standings] // By tournament ID
+	scheduler        *scheduler.Scheduler
+	seen             map[string]map[string]time.Time // When players last connected to a game of each tournament, by tournament ID
+	seenMu           sync.Mutex
+	notifier         notifier.Notifier // Reaches players without a notification stream, may be nil
+	mu               sync.RWMutex
 }
 
-func NewGameServer(storage storage.GameStorage) *GameServer {
+func NewGameServer(storage storage.GameStorage, puzzles storage.PuzzleStorage, friends storage.FriendStorage, tournaments storage.TournamentStorage, bots *bot.Registry, botMoveTime time.Duration, chatSettings ChatSettings, clk clock.Clock, notifier notifier.Notifier) *GameServer {
 
 	return &GameServer{
-		storage: storage,
+		storage:          storage,
+		solver:           solver.New(3, 3),
+		bots:             bots,
+		engines:          make(map[string]bot.Engine),
+		watchers:         make(map[string]int),
+		botMoveTime:      botMoveTime,
+		puzzles:          puzzles,
+		pending:          make(map[string]string),
+		attempted:        make(map[string]map[string]bool),
+		joinCodes:        make(map[string]joinCode),
+		gameCodes:        make(map[string]string),
+		joinAttempts:     chat.NewLimiter(joinAttemptBurst, joinAttemptInterval),
+		challenges:       make(map[string]*game.Challenge),
+		notifications:    newHub[*tictactoev1.Notification]("notifications"),
+		friends:          friends,
+		presence:         make(map[string]*presenceState),
+		presenceUpdates:  newHub[*tictactoev1.Friend]("presence"),
+		chat:             chatSettings,
+		chatMessages:     newHub[*tictactoev1.ChatMessage]("chat"),
+		tournaments:      tournaments,
+		standingsUpdates: newHub[*tictactoev1.Standings]("standings"),
+		scheduler:        scheduler.New(clk),
+		seen:             make(map[string]map[string]time.Time),
+		notifier:         notifier,
 	}
 }
 
@@ -40,30 +109,157 @@ func (gs *GameServer) GetPlayer(playerID string) (*game.Player, bool) {
 	return gs.storage.GetPlayer(context.Background(), playerID)
 }
 
-func (gs *GameServer) CreateGame(ctx context.Context, creator *game.Player, password string) (*game.Game, error) {
+func (gs *GameServer) CreateGame(ctx context.Context, creator *game.Player, settings game.Settings) (*game.Game, error) {
+	size, winLength, err := boardShape(settings.Size, settings.WinLength)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCorrespondence(settings); err != nil {
+		return nil, err
+	}
+	board := make([]string, size*size)
+	toMove := "X"
+	if settings.StartPosition != "" {
+		start, err := startPosition(settings.StartPosition)
+		if err != nil {
+			return nil, err
+		}
+		size, winLength, board, toMove = start.Size, start.WinLength, start.Board, start.ToMove
+		settings.StartPosition = start.String()
+	}
+
+	var passwordHash *game.PasswordHash
+	if settings.Password != "" {
+		passwordHash, err = game.HashPassword(settings.Password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+	}
+
 	newGame := &game.Game{
 		ID:            utils.GenerateUniqueID(),
 		PlayerX:       creator,
-		Board:         make([]string, 9),
+		Board:         board,
 		CurrentPlayer: creator,
 		Status:        tictactoev1.GameStatus_WAITING_FOR_PLAYER,
 		Event:         tictactoev1.GameEvent_GAME_CREATED,
-		Password:      password,
+		PasswordHash:  passwordHash,
 		Updates:       make(chan *tictactoev1.GameData, 10),
 		Players:       make(map[string]chan *tictactoev1.GameData),
+		AllowHints:    settings.AllowHints,
+		Size:          size,
+		WinLength:     winLength,
+		CreatedAt:     time.Now(),
+		StartPosition: settings.StartPosition,
+		DaysPerMove:   settings.DaysPerMove,
+	}
+	if toMove == "O" {
+		// O moves first from this position, so nobody can move until O is known.
+		newGame.CurrentPlayer = nil
+	}
+
+	var engine bot.Engine
+	if settings.Bot != "" {
+		engine, err = gs.bots.New(settings.Bot, size, winLength)
+		if err != nil {
+			return nil, err
+		}
+		newGame.PlayerO = &game.Player{
+			ID:   utils.GenerateUniqueID(),
+			Name: fmt.Sprintf("Bot (%s)", settings.Bot),
+		}
+		newGame.Status = tictactoev1.GameStatus_IN_PROGRESS
+		newGame.CurrentPlayer = gs.playerToMove(newGame)
 	}
 
 	if err := gs.storage.CreateGame(ctx, newGame); err != nil {
 		return nil, fmt.Errorf("failed to create game: %w", err)
 	}
 
+	if engine != nil {
+		gs.enginesMu.Lock()
+		gs.engines[newGame.ID] = engine
+		// Until the player subscribes to the game nobody watches it
+		gs.scheduleAbandon(newGame.ID)
+		gs.enginesMu.Unlock()
+	}
+
 	newGame.Players[creator.ID] = make(chan *tictactoev1.GameData, 10)
 	go gs.broadcastUpdates(ctx, newGame.ID)
+	if engine != nil && newGame.CurrentPlayer == newGame.PlayerO {
+		go gs.playBotMove(newGame.ID, engine)
+	}
 	return newGame, nil
 }
 
+// startPosition validates a custom starting position for a new game.
+func startPosition(notation string) (game.Position, error) {
+	start, err := game.ParsePosition(notation)
+	if err != nil {
+		return game.Position{}, err
+	}
+	if _, _, err := boardShape(start.Size, start.WinLength); err != nil {
+		return game.Position{}, err
+	}
+	if start.State != "-" {
+		return game.Position{}, errors.New("variant state is not supported")
+	}
+	if utils.CheckWin(start.Board, start.Size, start.WinLength) != "" || utils.IsBoardFull(start.Board) {
+		return game.Position{}, errors.New("starting position is already decided")
+	}
+	return start, nil
+}
+
+// playerToMove returns the player whose piece goes next on the board.
+func (gs *GameServer) playerToMove(g *game.Game) *game.Player {
+	if game.NewPosition(g.Board, g.Size, g.WinLength).ToMove == "O" {
+		return g.PlayerO
+	}
+	return g.PlayerX
+}
+
+// boardShape applies defaults to the requested board and validates it.
+func boardShape(size, winLength int) (int, int, error) {
+	if size == 0 {
+		size = 3
+	}
+	if winLength == 0 {
+		winLength = min(size, 5)
+	}
+	if size < 3 || size > game.MaxBoardSize {
+		return 0, 0, fmt.Errorf("board size must be between 3 and %d", game.MaxBoardSize)
+	}
+	if winLength < 3 || winLength > size {
+		return 0, 0, errors.New("win length must be between 3 and the board size")
+	}
+	return size, winLength, nil
+}
+
+// JoinGame seats player as O. A game with a password needs the password, or
+// can be joined with its join code instead, see JoinByCode.
 func (gs *GameServer) JoinGame(ctx context.Context, gameID string, player *game.Player, password string) (*game.Game, error) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gameData, err := gs.waitingGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
 
+	if gameData.PasswordHash != nil {
+		if !gs.joinAttempts.Allow(player.ID) {
+			return nil, ErrTooManyAttempts
+		}
+		if !gameData.PasswordHash.Matches(password) {
+			return nil, ErrIncorrectPassword
+		}
+	}
+
+	return gs.seatOpponent(ctx, gameData, player)
+}
+
+// waitingGame returns a game that can be joined.
+func (gs *GameServer) waitingGame(ctx context.Context, gameID string) (*game.Game, error) {
 	gameData, exists := gs.storage.GetGame(ctx, gameID)
 	if !exists {
 		return nil, errors.New("game not found")
@@ -72,30 +268,40 @@ func (gs *GameServer) JoinGame(ctx context.Context, gameID string, player *game.
 	if gameData.Status != tictactoev1.GameStatus_WAITING_FOR_PLAYER {
 		return nil, errors.New("game has already started / finished")
 	}
+	return gameData, nil
+}
 
-	if gameData.Password != password {
-		return nil, errors.New("incorrect password")
-	}
-
+// seatOpponent seats player as O in a game waiting for its second player and
+// starts it. It is called with gs.mu held.
+func (gs *GameServer) seatOpponent(ctx context.Context, gameData *game.Game, player *game.Player) (*game.Game, error) {
 	if gameData.PlayerO != nil {
 		return nil, errors.New("game is full")
 	}
 
-	gameData.Players[player.ID] = make(chan *tictactoev1.GameData, 10)
+	// Keep the stream of a player who followed the game before joining it
+	if _, ok := gameData.Players[player.ID]; !ok {
+		gameData.Players[player.ID] = make(chan *tictactoev1.GameData, 10)
+	}
 
 	gameData.PlayerO = player
 	gameData.Status = tictactoev1.GameStatus_IN_PROGRESS
 	gameData.Event = tictactoev1.GameEvent_PLAYER_JOINED
-	gameData.CurrentPlayer = gameData.PlayerX // First player (X) starts
+	gameData.CurrentPlayer = gs.playerToMove(gameData)
+	gs.resetMoveClock(gameData)
 
 	if err := gs.storage.UpdateGame(ctx, gameData); err != nil {
 		return nil, fmt.Errorf("failed to update game: %w", err)
 	}
 
+	gs.releaseJoinCode(gameData.ID)
+	gs.publish(gameData)
+	gs.notifyTurn(gameData)
 	return gameData, nil
 }
 
 func (gs *GameServer) MakeMove(ctx context.Context, gameID string, player *game.Player, position int32) (*game.Game, error) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
 
 	gameData, exists := gs.storage.GetGame(ctx, gameID)
 	if !exists {
@@ -104,7 +310,10 @@ func (gs *GameServer) MakeMove(ctx context.Context, gameID string, player *game.
 	if gameData.Status == tictactoev1.GameStatus_WAITING_FOR_PLAYER {
 		return nil, errors.New("game not started")
 	}
-	if position < 0 || position >= 9 {
+	if gameData.Status == tictactoev1.GameStatus_FINISHED {
+		return nil, errors.New("game is over")
+	}
+	if position < 0 || int(position) >= len(gameData.Board) {
 		return nil, errors.New("invalid position")
 	}
 	if gameData.CurrentPlayer.ID != player.ID {
@@ -114,14 +323,23 @@ func (gs *GameServer) MakeMove(ctx context.Context, gameID string, player *game.
 		return nil, errors.New("can't move here")
 	}
 
+	if gameData.TournamentID != "" {
+		gs.markSeen(gameData.TournamentID, player.ID)
+	}
+
 	symbol := "X"
 	if player.ID == gameData.PlayerO.ID {
 		symbol = "O"
 	}
 
 	gameData.Board[position] = symbol
+	gameData.Moves = append(gameData.Moves, position)
 
-	winner := utils.CheckWin(gameData.Board)
+	winner := utils.CheckWin(gameData.Board, gameData.Size, gameData.WinLength)
+	if winner != "" || utils.IsBoardFull(gameData.Board) {
+		slog.Info("Game finished", "game_id", gameID,
+			"position", game.FormatPosition(gameData.Board, gameData.Size, gameData.WinLength), "winner", winner)
+	}
 	if winner != "" {
 		gameData.Winner = player.Name
 		gameData.Status = tictactoev1.GameStatus_FINISHED
@@ -137,20 +355,54 @@ func (gs *GameServer) MakeMove(ctx context.Context, gameID string, player *game.
 		}
 		gameData.Event = tictactoev1.GameEvent_MOVE_MADE
 	}
+	gs.resetMoveClock(gameData)
 
 	if err := gs.storage.UpdateGame(ctx, gameData); err != nil {
 		return nil, fmt.Errorf("failed to update game: %w", err)
 	}
 
+	gs.publish(gameData)
+	gs.notifyTurn(gameData)
+
+	if gameData.Status == tictactoev1.GameStatus_FINISHED {
+		gs.releaseEngine(gameID)
+		if gameData.TournamentID != "" {
+			winnerID := ""
+			if winner != "" {
+				winnerID = player.ID
+			}
+			go gs.tournamentGameFinished(gameData.TournamentID, gameID, winnerID)
+		}
+	} else {
+		gs.enginesMu.Lock()
+		if engine, ok := gs.engines[gameID]; ok && gameData.CurrentPlayer == gameData.PlayerO {
+			go gs.playBotMove(gameID, engine)
+		}
+		gs.enginesMu.Unlock()
+	}
+
 	return gameData, nil
 }
 
 func (gs *GameServer) LeaveGame(ctx context.Context, gameID string, playerID string) (*game.Game, error) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	return gs.leaveGame(ctx, gameID, playerID)
+}
+
+// leaveGame is LeaveGame for callers that hold gs.mu.
+func (gs *GameServer) leaveGame(ctx context.Context, gameID string, playerID string) (*game.Game, error) {
 	gameData, exists := gs.storage.GetGame(ctx, gameID)
 	if !exists {
 		return nil, errors.New("game not found")
 	}
 
+	// Leaving a game in progress loses it
+	forfeited := gameData.Status == tictactoev1.GameStatus_IN_PROGRESS
+	// The broadcast of a finished game is over
+	wasFinished := gameData.Status == tictactoev1.GameStatus_FINISHED
+
 	if gameData.PlayerX != nil && playerID == gameData.PlayerX.ID {
 		gameData.PlayerX = nil
 	} else if gameData.PlayerO != nil && playerID == gameData.PlayerO.ID {
@@ -159,32 +411,121 @@ func (gs *GameServer) LeaveGame(ctx context.Context, gameID string, playerID str
 		return nil, errors.New("player is not in this game")
 	}
 
-	close(gameData.Players[playerID])
-	delete(gameData.Players, playerID)
+	if clientChan, ok := gameData.Players[playerID]; ok {
+		close(clientChan)
+		delete(gameData.Players, playerID)
+	}
+
+	gs.releaseEngine(gameID)
+	gs.releaseJoinCode(gameID)
 
 	gameData.Status = tictactoev1.GameStatus_FINISHED
 	gameData.Event = tictactoev1.GameEvent_PLAYER_LEAVED
 	gameData.CurrentPlayer = nil
+	gs.resetMoveClock(gameData)
 
 	if err := gs.storage.UpdateGame(ctx, gameData); err != nil {
 		return nil, fmt.Errorf("failed to update game: %w", err)
 	}
+	if !wasFinished {
+		// Tells whoever still follows the game, and ends its broadcast
+		gs.publish(gameData)
+	}
+	if forfeited {
+		gs.notifyTurn(gameData)
+	}
+
+	if forfeited && gameData.TournamentID != "" {
+		winner := gameData.PlayerX
+		if winner == nil {
+			winner = gameData.PlayerO
+		}
+		go gs.tournamentGameFinished(gameData.TournamentID, gameID, winner.ID)
+	}
 
 	if len(gameData.Players) == 0 {
 		err := gs.storage.DeleteGame(ctx, gameID)
 		if err != nil {
-			slog.Error("Game is not deleted: ", gameID)
+			slog.Error("Game is not deleted", "game_id", gameID)
 		}
 	}
 
 	return gameData, nil
 }
 
+// AnalyzeGame evaluates the current position of a game the player plays in.
+// While the game is in progress this is only allowed if the creator enabled
+// hints.
+func (gs *GameServer) AnalyzeGame(ctx context.Context, gameID, playerID string) ([]solver.Move, error) {
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return nil, errors.New("game not found")
+	}
+	if (gameData.PlayerX == nil || gameData.PlayerX.ID != playerID) && (gameData.PlayerO == nil || gameData.PlayerO.ID != playerID) {
+		return nil, ErrNotInGame
+	}
+	if gameData.Status == tictactoev1.GameStatus_IN_PROGRESS && !gameData.AllowHints {
+		return nil, ErrHintsDisabled
+	}
+	if gameData.Size != 3 {
+		return nil, errors.New("analysis is only available on 3x3 boards")
+	}
+
+	return gs.solver.Evaluate(gameData.Board)
+}
+
+// AnalyzeBoard evaluates an arbitrary board with X to move first.
+func (gs *GameServer) AnalyzeBoard(ctx context.Context, playerID string, board []string) ([]solver.Move, error) {
+	if err := gs.checkHintsAllowed(ctx, playerID); err != nil {
+		return nil, err
+	}
+	return gs.solver.Evaluate(board)
+}
+
+// AnalyzePosition evaluates a position given in position notation.
+func (gs *GameServer) AnalyzePosition(ctx context.Context, playerID, notation string) ([]solver.Move, error) {
+	pos, err := game.ParsePosition(notation)
+	if err != nil {
+		return nil, err
+	}
+	if pos.Size != 3 || pos.WinLength != 3 {
+		return nil, errors.New("analysis is only available on 3x3 boards")
+	}
+	if err := gs.checkHintsAllowed(ctx, playerID); err != nil {
+		return nil, err
+	}
+	return gs.solver.Evaluate(pos.Board)
+}
+
+// checkHintsAllowed refuses to analyze any board while the player plays a
+// 3x3 game without hints, since any position could be one of its next ones.
+// This binds the player's account only: nothing stops someone from
+// analyzing under another name.
+func (gs *GameServer) checkHintsAllowed(ctx context.Context, playerID string) error {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	for _, g := range gs.storage.ListPlayerGames(ctx, playerID) {
+		if g.Status == tictactoev1.GameStatus_IN_PROGRESS && !g.AllowHints && g.Size == 3 {
+			return ErrHintsDisabled
+		}
+	}
+	return nil
+}
+
 func (gs *GameServer) GetGame(gameID string) (*game.Game, bool) {
 	return gs.storage.GetGame(context.Background(), gameID)
 }
 
 func (gs *GameServer) GetGameData(ctx context.Context, gameID, playerId string) (chan *tictactoev1.GameData, error) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	return gs.gameStream(ctx, gameID, playerId)
+}
+
+// gameStream is GetGameData for callers that hold gs.mu.
+func (gs *GameServer) gameStream(ctx context.Context, gameID, playerId string) (chan *tictactoev1.GameData, error) {
 	gameData, exists := gs.storage.GetGame(ctx, gameID)
 	if !exists {
 		return nil, errors.New("game not found")
@@ -196,10 +537,73 @@ func (gs *GameServer) GetGameData(ctx context.Context, gameID, playerId string)
 		gameData.Players[playerId] = playerChan
 	}
 
+	// Following a game you play in shows you as in a game to your friends
+	if (gameData.PlayerX != nil && gameData.PlayerX.ID == playerId) || (gameData.PlayerO != nil && gameData.PlayerO.ID == playerId) {
+		gs.trackGameStream(ctx, playerId)
+		gs.watchBotGame(ctx, gameID)
+		if gameData.TournamentID != "" {
+			gs.markSeen(gameData.TournamentID, playerId)
+		}
+	}
+
 	return playerChan, nil
 }
 
+// Subscribe returns the update channel of a player in a game. A client that
+// reconnects passes the version of the last update it received, and gets the
+// current state of the game if it has missed updates since then.
+func (gs *GameServer) Subscribe(ctx context.Context, gameID, playerID string, version int64) (<-chan *tictactoev1.GameData, *tictactoev1.GameData, error) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	updates, err := gs.gameStream(ctx, gameID, playerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return nil, nil, errors.New("game not found")
+	}
+	if gameData.Version <= version {
+		return updates, nil, nil
+	}
+	return updates, game.GameToProto(gameData), nil
+}
+
+// publish queues the current state of the game for broadcasting to its players.
+func (gs *GameServer) publish(gameData *game.Game) {
+	gameData.Version++
+	gameData.Updates <- game.GameToProto(gameData)
+}
+
+// dropSlowClient disconnects a client that doesn't keep up with the updates
+// of a live game. A player leaves the game, a spectator only stops watching.
+// It is called by the broadcast, which has just taken an update off the
+// queue, so there is room to publish that the player left.
+func (gs *GameServer) dropSlowClient(ctx context.Context, gameID, playerID string) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gameData, exists := gs.storage.GetGame(ctx, gameID)
+	if !exists {
+		return
+	}
+	if (gameData.PlayerX != nil && gameData.PlayerX.ID == playerID) || (gameData.PlayerO != nil && gameData.PlayerO.ID == playerID) {
+		if _, err := gs.leaveGame(ctx, gameID, playerID); err != nil {
+			slog.Error("Leave game error", "error", err)
+		}
+		return
+	}
+	if clientChan, ok := gameData.Players[playerID]; ok {
+		close(clientChan)
+		delete(gameData.Players, playerID)
+	}
+}
+
 func (gs *GameServer) broadcastUpdates(ctx context.Context, gameID string) {
+	// Whichever way the game ends, its bot is no longer needed
+	defer gs.releaseEngine(gameID)
+
 	for {
 		gameData, exists := gs.storage.GetGame(ctx, gameID)
 		if !exists {
@@ -211,19 +615,38 @@ func (gs *GameServer) broadcastUpdates(ctx context.Context, gameID string) {
 			return
 		}
 
+		// Streams are opened and closed under gs.mu. Sending doesn't block,
+		// so holding it only takes a moment.
+		var slow []string
+		gs.mu.RLock()
 		for playerID, clientChan := range gameData.Players {
 			select {
 			case clientChan <- update:
 			default:
-				close(clientChan)
-				_, err := gs.LeaveGame(ctx, gameID, playerID)
-				if err != nil {
-					slog.Error("Leave game error: ", err)
+				if gameData.Correspondence() {
+					// Players of a correspondence game come and go, so one
+					// who is away only misses the oldest update
+					select {
+					case <-clientChan:
+					default:
+					}
+					select {
+					case clientChan <- update:
+					default:
+					}
+					continue
 				}
+				slow = append(slow, playerID)
 			}
 		}
+		gs.mu.RUnlock()
+		for _, playerID := range slow {
+			gs.dropSlowClient(ctx, gameID, playerID)
+		}
 
-		if gameData.Status == tictactoev1.GameStatus_FINISHED {
+		// A bot may already have finished the game while earlier updates are
+		// still queued, so only the final update ends the broadcast.
+		if update.Status == tictactoev1.GameStatus_FINISHED {
 			close(gameData.Updates)
 			return
 		}
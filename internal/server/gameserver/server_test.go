@@ -0,0 +1,194 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/bot"
+	"TicTacToe/internal/clock"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/storage/inmem"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var testStart = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// newTestServer returns a server on in-memory storage whose jobs run when
+// the returned clock is advanced.
+func newTestServer(t *testing.T, bots *bot.Registry) (*GameServer, *clock.Fake) {
+	t.Helper()
+	if bots == nil {
+		bots = bot.NewDefaultRegistry()
+	}
+	clk := clock.NewFake(testStart)
+	gs := NewGameServer(inmem.NewGameStorage(), inmem.NewPuzzleStorage(), inmem.NewFriendStorage(), inmem.NewTournamentStorage(),
+		bots, time.Second, ChatSettings{}, clk, nil)
+	t.Cleanup(gs.scheduler.Stop)
+	return gs, clk
+}
+
+func login(t *testing.T, gs *GameServer, name string) *game.Player {
+	t.Helper()
+	p, err := gs.LoginPlayer(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// eventually polls cond, which may depend on goroutines started by the server.
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+		if cond() {
+			return
+		}
+	}
+	t.Fatalf("timed out waiting until %s", what)
+}
+
+func TestNoAnalysisDuringGameWithoutHints(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+
+	x, o := login(t, gs, "x"), login(t, gs, "o")
+	g, err := gs.CreateGame(ctx, x, game.Settings{Size: 3, WinLength: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.JoinGame(ctx, g.ID, o, ""); err != nil {
+		t.Fatal(err)
+	}
+	// A position the game hasn't reached is refused as well
+	if _, err := gs.AnalyzePosition(ctx, o.ID, "x2/3/3 o 3 -"); !errors.Is(err, ErrHintsDisabled) {
+		t.Errorf("analysis during the game: %v, want ErrHintsDisabled", err)
+	}
+	if _, err := gs.AnalyzeBoard(ctx, login(t, gs, "spectator").ID, make([]string, 9)); err != nil {
+		t.Errorf("analysis by a player outside the game: %v", err)
+	}
+
+	if _, err := gs.LeaveGame(ctx, g.ID, o.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.AnalyzePosition(ctx, o.ID, "x2/3/3 o 3 -"); err != nil {
+		t.Errorf("analysis after the game: %v", err)
+	}
+}
+
+// nextUpdate waits for the next update of a stream.
+func nextUpdate(t *testing.T, updates <-chan *tictactoev1.GameData) *tictactoev1.GameData {
+	t.Helper()
+	select {
+	case update, ok := <-updates:
+		if !ok {
+			t.Fatal("update stream closed")
+		}
+		return update
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for an update")
+		return nil
+	}
+}
+
+// lastUpdate reads a stream up to the update that finishes the game.
+func lastUpdate(t *testing.T, updates <-chan *tictactoev1.GameData) *tictactoev1.GameData {
+	t.Helper()
+	for {
+		if update := nextUpdate(t, updates); update.Status == tictactoev1.GameStatus_FINISHED {
+			return update
+		}
+	}
+}
+
+func TestOpponentSeesLeave(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+
+	x, o := login(t, gs, "x"), login(t, gs, "o")
+	g, err := gs.CreateGame(ctx, x, game.Settings{Size: 3, WinLength: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.JoinGame(ctx, g.ID, o, ""); err != nil {
+		t.Fatal(err)
+	}
+	updates, _, err := gs.Subscribe(ctx, g.ID, x.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.LeaveGame(ctx, g.ID, o.ID); err != nil {
+		t.Fatal(err)
+	}
+	if last := lastUpdate(t, updates); last.Event != tictactoev1.GameEvent_PLAYER_LEAVED || last.PlayerO != nil {
+		t.Errorf("last update: event %v with O %v, want O to have left", last.Event, last.PlayerO)
+	}
+
+	// The game is over, so leaving it too publishes nothing more
+	if _, err := gs.LeaveGame(ctx, g.ID, x.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubscribeThenJoin(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+
+	g, err := gs.CreateGame(ctx, login(t, gs, "x"), game.Settings{Size: 3, WinLength: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The browser client opens the game's stream before joining it
+	o := login(t, gs, "o")
+	updates, _, err := gs.Subscribe(ctx, g.ID, o.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.JoinGame(ctx, g.ID, o, ""); err != nil {
+		t.Fatal(err)
+	}
+	if update := nextUpdate(t, updates); update.Event != tictactoev1.GameEvent_PLAYER_JOINED {
+		t.Errorf("event = %v, want PLAYER_JOINED", update.Event)
+	}
+}
+
+func TestStreamResumesAcrossJoin(t *testing.T) {
+	gs, _ := newTestServer(t, nil)
+	ctx := context.Background()
+
+	x, o := login(t, gs, "x"), login(t, gs, "o")
+	g, err := gs.CreateGame(ctx, x, game.Settings{Size: 3, WinLength: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updates, _, err := gs.Subscribe(ctx, g.ID, o.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gs.JoinGame(ctx, g.ID, o, ""); err != nil {
+		t.Fatal(err)
+	}
+	joined := nextUpdate(t, updates)
+	if _, err := gs.MakeMove(ctx, g.ID, x, 4); err != nil {
+		t.Fatal(err)
+	}
+	moved := nextUpdate(t, updates)
+	if moved.Version != joined.Version+1 {
+		t.Errorf("versions %d then %d, want consecutive updates", joined.Version, moved.Version)
+	}
+
+	// Reconnecting with the last version seen resumes the same stream
+	resumed, missed, err := gs.Subscribe(ctx, g.ID, o.ID, moved.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missed != nil {
+		t.Errorf("resumed with the current state at version %d, nothing was missed", missed.Version)
+	}
+	if _, err := gs.MakeMove(ctx, g.ID, o, 0); err != nil {
+		t.Fatal(err)
+	}
+	if update := nextUpdate(t, resumed); update.Version != moved.Version+1 {
+		t.Errorf("version %d after resuming at %d", update.Version, moved.Version)
+	}
+}
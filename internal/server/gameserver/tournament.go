@@ -0,0 +1,195 @@
+package gameserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"TicTacToe/internal/tournament"
+	"TicTacToe/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrTournamentNotFound   = errors.New("tournament not found")
+	ErrNotTournamentCreator = errors.New("only the creator of the tournament can start it")
+	ErrInvalidTournament    = errors.New("unknown tournament format")
+)
+
+// CreateTournament creates a tournament open for registration. Its games are
+// played with settings, without a bot or password. A tournament with a start
+// time in its schedule is started then by the server.
+func (gs *GameServer) CreateTournament(ctx context.Context, creator *game.Player, name string, format tictactoev1.TournamentFormat, settings game.Settings, bestOf, rounds int, schedule tournament.Schedule) (*tournament.Tournament, error) {
+	if _, ok := tictactoev1.TournamentFormat_name[int32(format)]; !ok {
+		return nil, ErrInvalidTournament
+	}
+	if name = strings.TrimSpace(name); name == "" {
+		name = creator.Name + "'s tournament"
+	}
+	settings.Bot = ""
+	settings.Password = ""
+	// Check the settings now rather than when the first game is created
+	if _, _, err := boardShape(settings.Size, settings.WinLength); err != nil {
+		return nil, err
+	}
+	if settings.StartPosition != "" {
+		if _, err := startPosition(settings.StartPosition); err != nil {
+			return nil, err
+		}
+	}
+
+	t, err := tournament.New(utils.GenerateUniqueID(), name, format, creator, settings, bestOf, rounds, schedule, gs.scheduler.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := gs.tournaments.CreateTournament(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to create tournament: %w", err)
+	}
+	gs.scheduleStart(t)
+	return t, nil
+}
+
+// ListTournaments returns all tournaments, newest first.
+func (gs *GameServer) ListTournaments(ctx context.Context) []*tictactoev1.Tournament {
+	gs.tournamentsMu.Lock()
+	defer gs.tournamentsMu.Unlock()
+
+	tournaments := gs.tournaments.ListTournaments(ctx)
+	slices.SortFunc(tournaments, func(a, b *tournament.Tournament) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	list := make([]*tictactoev1.Tournament, len(tournaments))
+	for i, t := range tournaments {
+		list[i] = tournament.ToProto(t, false)
+	}
+	return list
+}
+
+// RegisterForTournament adds a player to a tournament that hasn't started,
+// while its registration is open.
+func (gs *GameServer) RegisterForTournament(ctx context.Context, player *game.Player, tournamentID string) (*tictactoev1.Tournament, error) {
+	return gs.updateTournament(ctx, tournamentID, func(t *tournament.Tournament) error {
+		return t.Register(player, gs.scheduler.Now())
+	})
+}
+
+// StartTournament pairs the first round and creates its games. Only the
+// creator of the tournament can start it.
+func (gs *GameServer) StartTournament(ctx context.Context, player *game.Player, tournamentID string) (*tictactoev1.Tournament, error) {
+	return gs.updateTournament(ctx, tournamentID, func(t *tournament.Tournament) error {
+		if t.Creator.ID != player.ID {
+			return ErrNotTournamentCreator
+		}
+		return gs.startTournament(ctx, t)
+	})
+}
+
+func (gs *GameServer) startTournament(ctx context.Context, t *tournament.Tournament) error {
+	matches, err := t.Start()
+	if err != nil {
+		return err
+	}
+	gs.startMatches(ctx, t, matches)
+	return nil
+}
+
+// Standings returns a tournament with its matches and standings.
+func (gs *GameServer) Standings(ctx context.Context, tournamentID string) (*tictactoev1.Standings, error) {
+	gs.tournamentsMu.Lock()
+	defer gs.tournamentsMu.Unlock()
+
+	t, exists := gs.tournaments.GetTournament(ctx, tournamentID)
+	if !exists {
+		return nil, ErrTournamentNotFound
+	}
+	return tournament.StandingsToProto(t), nil
+}
+
+// SubscribeStandings streams the standings of a tournament until ctx is done,
+// starting with the current standings and again after every change.
+func (gs *GameServer) SubscribeStandings(ctx context.Context, tournamentID string) (<-chan *tictactoev1.Standings, error) {
+	// Changes are sent while holding tournamentsMu, so none are missed
+	gs.tournamentsMu.Lock()
+	defer gs.tournamentsMu.Unlock()
+
+	t, exists := gs.tournaments.GetTournament(ctx, tournamentID)
+	if !exists {
+		return nil, ErrTournamentNotFound
+	}
+	initial := []*tictactoev1.Standings{tournament.StandingsToProto(t)}
+	return gs.standingsUpdates.subscribe(ctx, tournamentID, initial), nil
+}
+
+// updateTournament changes a tournament and sends its new standings. It
+// schedules the no-show deadline of rounds the change started.
+func (gs *GameServer) updateTournament(ctx context.Context, tournamentID string, update func(*tournament.Tournament) error) (*tictactoev1.Tournament, error) {
+	gs.tournamentsMu.Lock()
+	defer gs.tournamentsMu.Unlock()
+
+	t, exists := gs.tournaments.GetTournament(ctx, tournamentID)
+	if !exists {
+		return nil, ErrTournamentNotFound
+	}
+	round, now := t.Round, gs.scheduler.Now()
+	if err := update(t); err != nil {
+		return nil, err
+	}
+	switch {
+	case t.Status == tictactoev1.TournamentStatus_COMPLETED:
+		gs.unschedule(t.ID)
+	case t.Round != round:
+		gs.roundStarted(t, now)
+	}
+	if err := gs.tournaments.UpdateTournament(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to update tournament: %w", err)
+	}
+	gs.standingsUpdates.send(t.ID, tournament.StandingsToProto(t))
+	return tournament.ToProto(t, true), nil
+}
+
+// tournamentGameFinished records the result of a tournament game, and starts
+// the next game of its match or the next round. winnerID is empty for a draw.
+func (gs *GameServer) tournamentGameFinished(tournamentID, gameID, winnerID string) {
+	ctx := context.Background()
+	_, err := gs.updateTournament(ctx, tournamentID, func(t *tournament.Tournament) error {
+		gs.startMatches(ctx, t, t.RecordGame(gameID, winnerID))
+		if t.Status == tictactoev1.TournamentStatus_COMPLETED {
+			slog.Info("Tournament finished", "tournament_id", t.ID, "winner", t.Standings()[0].Player.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		slog.Error("Failed to record tournament game", "tournament_id", tournamentID, "game_id", gameID, "error", err)
+	}
+}
+
+// startMatches creates the next game of each match, with both players
+// seated, and tells the players it is ready.
+func (gs *GameServer) startMatches(ctx context.Context, t *tournament.Tournament, matches []*tournament.Match) {
+	for _, m := range matches {
+		x, o := m.NextGame()
+		newGame, err := gs.CreateGame(ctx, x, t.Settings)
+		if err != nil {
+			slog.Error("Failed to create tournament game", "tournament_id", t.ID, "error", err)
+			continue
+		}
+		newGame.TournamentID = t.ID
+		newGame, err = gs.JoinGame(ctx, newGame.ID, o, "")
+		if err != nil {
+			slog.Error("Failed to create tournament game", "tournament_id", t.ID, "error", err)
+			continue
+		}
+		m.Games = append(m.Games, newGame.ID)
+
+		ready := &tictactoev1.Notification{
+			Type:         tictactoev1.NotificationType_TOURNAMENT_GAME_READY,
+			Game:         game.GameToProto(newGame),
+			TournamentId: t.ID,
+		}
+		gs.notify(x.ID, ready)
+		gs.notify(o.ID, ready)
+	}
+}
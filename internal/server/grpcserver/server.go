@@ -3,8 +3,10 @@ package grpcserver
 import (
 	"TicTacToe/internal/grpc/interceptors"
 	"TicTacToe/internal/server/gameserver"
+	"crypto/tls"
 	"fmt"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"log/slog"
 	"net"
 )
@@ -15,11 +17,16 @@ type GRPCServer struct {
 	port   int
 }
 
-// NewGRPCServer initializes a new GRPCServer instance.
-func NewGRPCServer(port int, gameSrv *gameserver.GameServer) *GRPCServer {
+// NewGRPCServer initializes a new GRPCServer instance. It serves plaintext
+// when tlsConfig is nil.
+func NewGRPCServer(port int, gameSrv *gameserver.GameServer, tlsConfig *tls.Config) *GRPCServer {
 	interceptor := interceptors.AuthInterceptor(gameSrv)
 
-	grpcSrv := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(interceptor)}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcSrv := grpc.NewServer(opts...)
 
 	return &GRPCServer{
 		Server: grpcSrv,
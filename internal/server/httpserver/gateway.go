@@ -0,0 +1,337 @@
+package httpserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/server/gameserver"
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Routes of the GameService methods. Path wildcards are named after request
+// fields; the remaining fields come from the JSON body or the query string.
+// Methods without a route are served at "POST /v1/<Method>".
+var routes = map[string]string{
+	"Login":                 "POST /v1/login",
+	"CreateGame":            "POST /v1/games",
+	"JoinGame":              "POST /v1/games/{game_id}/join",
+	"LeaveGame":             "POST /v1/games/{game_id}/leave",
+	"MakeMove":              "POST /v1/games/{game_id}/moves",
+	"GetGameState":          "GET /v1/games/{game_id}/state",
+	"AnalyzePosition":       "POST /v1/analysis",
+	"ListBots":              "GET /v1/bots",
+	"GetPuzzle":             "GET /v1/puzzles/next",
+	"SubmitPuzzleSolution":  "POST /v1/puzzles/{puzzle_id}/solution",
+	"ExportGame":            "GET /v1/games/{game_id}/record",
+	"ImportGame":            "POST /v1/games/import",
+	"RenderGame":            "GET /v1/games/{game_id}/image",
+	"GetInvite":             "GET /v1/games/{game_id}/invite",
+	"RevokeJoinCode":        "DELETE /v1/games/{game_id}/code",
+	"JoinByCode":            "POST /v1/codes/{code}/join",
+	"ChallengePlayer":       "POST /v1/players/{player_id}/challenges",
+	"AcceptChallenge":       "POST /v1/challenges/{challenge_id}/accept",
+	"DeclineChallenge":      "POST /v1/challenges/{challenge_id}/decline",
+	"GetNotifications":      "GET /v1/notifications",
+	"SendFriendRequest":     "POST /v1/players/{player_id}/friend-requests",
+	"AnswerFriendRequest":   "POST /v1/friend-requests/{player_id}/answer",
+	"ListFriendRequests":    "GET /v1/friend-requests",
+	"RemoveFriend":          "DELETE /v1/friends/{player_id}",
+	"ListFriends":           "GET /v1/friends",
+	"Heartbeat":             "POST /v1/heartbeat",
+	"SubscribePresence":     "GET /v1/friends/presence",
+	"SendChatMessage":       "POST /v1/games/{game_id}/chat",
+	"GetChat":               "GET /v1/games/{game_id}/chat",
+	"SendReaction":          "POST /v1/games/{game_id}/reactions",
+	"CreateTournament":      "POST /v1/tournaments",
+	"ListTournaments":       "GET /v1/tournaments",
+	"RegisterForTournament": "POST /v1/tournaments/{tournament_id}/register",
+	"StartTournament":       "POST /v1/tournaments/{tournament_id}/start",
+	"GetStandings":          "GET /v1/tournaments/{tournament_id}/standings",
+	"SubscribeStandings":    "GET /v1/tournaments/{tournament_id}/standings/stream",
+	"MyActiveGames":         "GET /v1/games/active",
+}
+
+// Largest accepted request body
+const maxBodySize = 1 << 20
+
+var wildcardPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+var (
+	marshalOptions   = protojson.MarshalOptions{EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{}
+)
+
+// gateway translates HTTP requests into calls of the generated GameService
+// handlers, so they behave exactly like their gRPC counterparts.
+type gateway struct {
+	api         tictactoev1.GameServiceServer
+	gameServer  *gameserver.GameServer
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func newGateway(api tictactoev1.GameServiceServer, gameSrv *gameserver.GameServer, interceptor grpc.UnaryServerInterceptor) http.Handler {
+	g := &gateway{api: api, gameServer: gameSrv, interceptor: interceptor}
+	desc := tictactoev1.GameService_ServiceDesc
+
+	mux := http.NewServeMux()
+	for _, m := range desc.Methods {
+		pattern := routeFor(m.MethodName)
+		mux.Handle(pattern, g.unary(m, pathFields(pattern)))
+	}
+	for _, s := range desc.Streams {
+		pattern := routeFor(s.StreamName)
+		mux.Handle(pattern, g.stream(s, pathFields(pattern)))
+	}
+	mux.HandleFunc("GET /v1/games/{game_id}/ws", g.socket)
+	registerWeb(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, status.Error(codes.NotFound, "unknown endpoint "+r.Method+" "+r.URL.Path))
+	})
+	return mux
+}
+
+func routeFor(method string) string {
+	if pattern, ok := routes[method]; ok {
+		return pattern
+	}
+	return "POST /v1/" + method
+}
+
+func pathFields(pattern string) []string {
+	var fields []string
+	for _, m := range wildcardPattern.FindAllStringSubmatch(pattern, -1) {
+		fields = append(fields, m[1])
+	}
+	return fields
+}
+
+func (g *gateway) unary(m grpc.MethodDesc, fields []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		dec := func(v interface{}) error {
+			return decodeRequest(r, fields, v)
+		}
+		resp, err := m.Handler(g.api, incomingContext(r), dec, g.interceptor)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeMessage(w, resp)
+	}
+}
+
+// call invokes a unary method by name, through the interceptor.
+func (g *gateway) call(ctx context.Context, method string, req proto.Message) (interface{}, error) {
+	for _, m := range tictactoev1.GameService_ServiceDesc.Methods {
+		if m.MethodName != method {
+			continue
+		}
+		dec := func(v interface{}) error {
+			proto.Merge(v.(proto.Message), req)
+			return nil
+		}
+		return m.Handler(g.api, ctx, dec, g.interceptor)
+	}
+	return nil, status.Error(codes.Unimplemented, "unknown method "+method)
+}
+
+// stream serves a server-streaming method as newline-delimited JSON, one
+// message per line.
+func (g *gateway) stream(s grpc.StreamDesc, fields []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		st := &serverStream{ctx: incomingContext(r), w: w, r: r, fields: fields}
+		err := s.Handler(g.api, st)
+		if err == nil {
+			return
+		}
+		if !st.sent {
+			writeError(w, err)
+			return
+		}
+		// The status line is gone, report the error in the stream instead.
+		body, _ := marshalOptions.Marshal(status.Convert(err).Proto())
+		fmt.Fprintf(w, "{\"error\":%s}\n", body)
+	}
+}
+
+// incomingContext passes the player id from an "Authorization: Bearer <id>"
+// header the same way gRPC clients pass it, as player-id metadata.
+func incomingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		md.Set("player-id", strings.TrimSpace(token))
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+// decodeRequest fills a request message from the JSON body, the query string
+// and the path wildcards, in increasing order of precedence.
+func decodeRequest(r *http.Request, fields []string, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected request type %T", v)
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "failed to read request body: "+err.Error())
+	}
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err := unmarshalOptions.Unmarshal(body, msg); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid request body: "+err.Error())
+		}
+	}
+
+	m := msg.ProtoReflect()
+	for name, values := range r.URL.Query() {
+		if err := setField(m, name, values); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, name := range fields {
+		if err := setField(m, name, []string{r.PathValue(name)}); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return nil
+}
+
+// setField sets a scalar or repeated scalar field, named like in the proto
+// file or in JSON, from its text form.
+func setField(m protoreflect.Message, name string, values []string) error {
+	fields := m.Descriptor().Fields()
+	fd := fields.ByName(protoreflect.Name(name))
+	if fd == nil {
+		fd = fields.ByJSONName(name)
+	}
+	if fd == nil || len(values) == 0 {
+		return fmt.Errorf("unknown field %q", name)
+	}
+
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		for _, s := range values {
+			v, err := parseValue(fd, s)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+	v, err := parseValue(fd, values[len(values)-1])
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+func parseValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	invalid := fmt.Errorf("invalid value %q for field %s", s, fd.Name())
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return protoreflect.Value{}, invalid
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, invalid
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, invalid
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, invalid
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("field %s cannot be set from the URL", fd.Name())
+}
+
+func writeMessage(w http.ResponseWriter, v interface{}) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		writeError(w, status.Errorf(codes.Internal, "unexpected response type %T", v))
+		return
+	}
+	body, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		writeError(w, status.Error(codes.Internal, "failed to encode response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// writeError writes a gRPC status as JSON, with the matching HTTP status code.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, _ := marshalOptions.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(body)
+}
+
+// serverStream adapts an HTTP response to grpc.ServerStream.
+type serverStream struct {
+	ctx    context.Context
+	w      http.ResponseWriter
+	r      *http.Request
+	fields []string
+	sent   bool
+}
+
+func (s *serverStream) SetHeader(metadata.MD) error  { return nil }
+func (s *serverStream) SendHeader(metadata.MD) error { return nil }
+func (s *serverStream) SetTrailer(metadata.MD)       {}
+func (s *serverStream) Context() context.Context     { return s.ctx }
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	return decodeRequest(s.r, s.fields, m)
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return errors.New("unexpected message type")
+	}
+	body, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if !s.sent {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.sent = true
+	}
+	if _, err := s.w.Write(append(body, '\n')); err != nil {
+		return err
+	}
+	http.NewResponseController(s.w).Flush()
+	return nil
+}
@@ -0,0 +1,75 @@
+package httpserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/grpc/interceptors"
+	"TicTacToe/internal/server/gameserver"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Time given to open requests, such as game state streams, to finish on Stop
+const shutdownTimeout = 5 * time.Second
+
+// HTTPServer serves the GameService as REST/JSON next to the gRPC server.
+type HTTPServer struct {
+	Server *http.Server
+	port   int
+}
+
+// NewHTTPServer initializes a new HTTPServer instance. Requests go through
+// the same auth interceptor as the gRPC server. It serves plaintext when
+// tlsConfig is nil.
+func NewHTTPServer(port int, gameSrv *gameserver.GameServer, api tictactoev1.GameServiceServer, tlsConfig *tls.Config) *HTTPServer {
+	gw := newGateway(api, gameSrv, interceptors.AuthInterceptor(gameSrv))
+
+	return &HTTPServer{
+		Server: &http.Server{
+			Handler:           gw,
+			ReadHeaderTimeout: 10 * time.Second,
+			TLSConfig:         tlsConfig,
+		},
+		port: port,
+	}
+}
+
+// Start starts the HTTP server on the specified port.
+func (h *HTTPServer) Start() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", h.port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	slog.Info("Starting HTTP server", "port", h.port, "tls", h.Server.TLSConfig != nil)
+
+	serve := h.Server.Serve
+	if h.Server.TLSConfig != nil {
+		// The certificate comes from the TLS config
+		serve = func(l net.Listener) error { return h.Server.ServeTLS(l, "", "") }
+	}
+	if err := serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve: %w", err)
+	}
+
+	return nil
+}
+
+// Stop gracefully stops the HTTP server, closing the connections that are
+// still open after the shutdown timeout.
+func (h *HTTPServer) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := h.Server.Shutdown(ctx); err != nil {
+		h.Server.Close()
+		slog.Warn("HTTP server stopped forcefully", "error", err)
+		return
+	}
+	slog.Info("HTTP server stopped gracefully")
+}
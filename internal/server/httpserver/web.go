@@ -0,0 +1,35 @@
+package httpserver
+
+import (
+	"TicTacToe/resources"
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// The browser client, a single page that talks to the gateway
+//
+//go:embed web
+var webFiles embed.FS
+
+// registerWeb serves the browser client at / and at invite links /g/<id>.
+func registerWeb(mux *http.ServeMux) {
+	static, _ := fs.Sub(webFiles, "web")
+
+	page := func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFileFS(w, r, static, "index.html")
+	}
+	mux.HandleFunc("GET /{$}", page)
+	mux.HandleFunc("GET /g/{game_id}", page)
+	mux.Handle("GET /static/", http.StripPrefix("/static/", http.FileServerFS(static)))
+	mux.HandleFunc("GET /static/x.png", pngFile(resources.XImage))
+	mux.HandleFunc("GET /static/o.png", pngFile(resources.OImage))
+}
+
+func pngFile(data []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		w.Write(data)
+	}
+}
@@ -0,0 +1,394 @@
+// Browser client for the HTTP gateway. Games are followed over the WebSocket
+// bridge, which also carries the moves.
+"use strict";
+
+const $ = (id) => document.getElementById(id);
+
+let player = JSON.parse(localStorage.getItem("player") || "null");
+let game = null;
+let socket = null;
+let retries = 0;
+// Invite of the game the player created: its join code is shown for reading
+// out and goes into its link.
+let invite = { gameId: "", joinCode: "" };
+
+// Calls a REST endpoint of the gateway.
+async function api(method, path, body) {
+  const headers = { "Content-Type": "application/json" };
+  if (player) {
+    headers.Authorization = "Bearer " + player.playerId;
+  }
+  const resp = await fetch(path, {
+    method,
+    headers,
+    body: body === undefined ? undefined : JSON.stringify(body),
+  });
+  const data = await resp.json();
+  if (!resp.ok) {
+    // The server forgets players on restart.
+    if (resp.status === 401) {
+      logout();
+    }
+    throw new Error(data.message || resp.statusText);
+  }
+  return data;
+}
+
+function showError(err) {
+  $("error").textContent = err ? err.message || String(err) : "";
+}
+
+function show(section) {
+  for (const id of ["login", "lobby", "game"]) {
+    $(id).hidden = id !== section;
+  }
+  showError(null);
+}
+
+function logout() {
+  player = null;
+  localStorage.removeItem("player");
+  closeSocket();
+  show("login");
+}
+
+// Routes by URL: /g/<id> opens a game, anything else the lobby.
+function route() {
+  if (!player) {
+    show("login");
+    return;
+  }
+  const match = location.pathname.match(/^\/g\/([^/]+)$/);
+  if (match) {
+    openGame(decodeURIComponent(match[1]));
+  } else {
+    closeSocket();
+    game = null;
+    showLobby();
+  }
+}
+
+function navigate(path) {
+  history.pushState(null, "", path);
+  route();
+}
+
+$("login-form").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  try {
+    player = await api("POST", "/v1/login", { playerName: $("player-name").value.trim() });
+    localStorage.setItem("player", JSON.stringify(player));
+    route();
+  } catch (err) {
+    showError(err);
+  }
+});
+
+$("logout").addEventListener("click", (e) => {
+  e.preventDefault();
+  logout();
+});
+
+async function showLobby() {
+  show("lobby");
+  $("lobby-name").textContent = player.playerName;
+  try {
+    const bots = await api("GET", "/v1/bots");
+    const opponent = $("opponent");
+    opponent.length = 1;
+    for (const name of bots.names) {
+      opponent.add(new Option("Bot (" + name + ")", name));
+    }
+  } catch (err) {
+    showError(err);
+  }
+}
+
+function fillShapes() {
+  const size = $("board-size");
+  for (let n = 3; n <= 19; n++) {
+    size.add(new Option(n + "x" + n, n));
+  }
+  const updateWinLength = () => {
+    const n = Number(size.value);
+    const win = $("win-length");
+    win.length = 0;
+    for (let k = 3; k <= n; k++) {
+      win.add(new Option(k, k, false, k === Math.min(n, 5)));
+    }
+  };
+  size.addEventListener("change", updateWinLength);
+  updateWinLength();
+}
+
+$("create-form").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  try {
+    const created = await api("POST", "/v1/games", {
+      boardSize: Number($("board-size").value),
+      winLength: Number($("win-length").value),
+      bot: $("opponent").value,
+      password: $("create-password").value,
+    });
+    navigate("/g/" + created.id);
+  } catch (err) {
+    showError(err);
+  }
+});
+
+// Short join codes, as issued by the server.
+const joinCodePattern = /^[2-9A-HJKMNP-Z]{6}$/;
+
+$("join-form").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  const value = $("join-id").value.trim();
+  const code = value.toUpperCase().replace(/[-\s]/g, "");
+  if (joinCodePattern.test(code)) {
+    joinByCode(code);
+    return;
+  }
+  // Invite links keep their join code.
+  const url = new URL(value, location.origin);
+  navigate(url.pathname.startsWith("/g/") ? url.pathname + url.search : "/g/" + encodeURIComponent(value));
+});
+
+function openGame(id) {
+  show("game");
+  if (!game || game.id !== id) {
+    game = { id, version: -1 };
+    $("board").replaceChildren();
+    $("status").textContent = "Connecting…";
+  }
+  connect(id);
+}
+
+// Connects to the game's WebSocket, resuming from the last version seen.
+function connect(id) {
+  closeSocket();
+  const scheme = location.protocol === "https:" ? "wss:" : "ws:";
+  const params = new URLSearchParams({ token: player.playerId, version: game.version });
+  const ws = new WebSocket(`${scheme}//${location.host}/v1/games/${encodeURIComponent(id)}/ws?${params}`);
+  socket = ws;
+
+  ws.onopen = () => {
+    retries = 0;
+  };
+  ws.onmessage = (e) => {
+    const frame = JSON.parse(e.data);
+    switch (frame.type) {
+      case "state":
+        showError(null);
+        render(frame.game);
+        break;
+      case "error":
+        showError(frame.error);
+        break;
+      case "ping":
+        ws.send(JSON.stringify({ type: "pong" }));
+        break;
+    }
+  };
+  ws.onclose = () => {
+    if (socket !== ws) {
+      return;
+    }
+    socket = null;
+    if (game && game.version < 0) {
+      // Never got the game, so it does not exist or we may not see it.
+      $("status").textContent = "Game not found.";
+      return;
+    }
+    if (game && game.status !== "FINISHED") {
+      retries++;
+      $("status").textContent = "Connection lost, reconnecting…";
+      setTimeout(() => {
+        // Unless the player has moved on meanwhile
+        if (!socket && game && game.id === id) {
+          connect(id);
+        }
+      }, Math.min(1000 * 2 ** retries, 15000));
+    }
+  };
+}
+
+// Joins by join code, which needs no password.
+async function joinByCode(code) {
+  try {
+    const joined = await api("POST", `/v1/codes/${encodeURIComponent(code)}/join`, {});
+    navigate("/g/" + encodeURIComponent(joined.id));
+  } catch (err) {
+    showError(err);
+  }
+}
+
+function closeSocket() {
+  if (socket) {
+    const ws = socket;
+    socket = null;
+    ws.close();
+  }
+}
+
+function mySymbol(g) {
+  if (g.playerX && g.playerX.playerId === player.playerId) {
+    return "X";
+  }
+  if (g.playerO && g.playerO.playerId === player.playerId) {
+    return "O";
+  }
+  return "";
+}
+
+function render(g) {
+  game = g;
+  const me = mySymbol(g);
+  const myTurn = g.status === "IN_PROGRESS" && g.currentPlayer && g.currentPlayer.playerId === player.playerId;
+
+  const inviting = g.status === "WAITING_FOR_PLAYER" && me === "X";
+  if (inviting && invite.gameId !== g.id) {
+    loadInvite(g.id);
+  }
+  let link = location.origin + "/g/" + g.id;
+  if (invite.gameId === g.id && invite.joinCode) {
+    link += "?code=" + encodeURIComponent(invite.joinCode);
+  }
+  $("invite-link").value = link;
+  $("join-code").textContent = invite.gameId === g.id ? invite.joinCode : "";
+  $("invite").hidden = !inviting;
+  $("join-game").hidden = !(g.status === "WAITING_FOR_PLAYER" && me === "");
+  $("join-password").hidden = !g.hasPassword || linkCode() !== "";
+  $("new-game").hidden = g.status !== "FINISHED";
+  $("leave").hidden = g.status === "FINISHED" || me === "";
+
+  $("status").textContent = statusText(g, me, myTurn);
+
+  const board = $("board");
+  board.style.gridTemplateColumns = `repeat(${g.boardSize}, 1fr)`;
+  if (board.children.length !== g.board.length) {
+    board.replaceChildren();
+    g.board.forEach((_, i) => {
+      const cell = document.createElement("button");
+      cell.addEventListener("click", () => move(i));
+      board.append(cell);
+    });
+  }
+  const last = g.moves.length ? g.moves[g.moves.length - 1] : -1;
+  const winning = winningCells(g.board, g.boardSize, g.winLength);
+  g.board.forEach((piece, i) => {
+    const cell = board.children[i];
+    cell.className = piece.toLowerCase();
+    cell.classList.toggle("last", i === last);
+    cell.classList.toggle("winning", winning.has(i));
+    cell.disabled = !myTurn || piece !== "";
+    cell.setAttribute("aria-label", piece || "empty");
+  });
+}
+
+// Fetches the invite of a game the player created. With the join code, the
+// link can be used without the game's password.
+async function loadInvite(gameId) {
+  invite = { gameId, joinCode: "" };
+  try {
+    const data = await api("GET", `/v1/games/${encodeURIComponent(gameId)}/invite`);
+    invite.joinCode = data.joinCode;
+    if (game && game.id === gameId) {
+      render(game);
+    }
+  } catch (err) {
+    showError(err);
+  }
+}
+
+// Join code of the link the game was opened with.
+function linkCode() {
+  return new URLSearchParams(location.search).get("code") || "";
+}
+
+function statusText(g, me, myTurn) {
+  switch (g.status) {
+    case "WAITING_FOR_PLAYER":
+      return me ? "Waiting for an opponent…" : "This game is waiting for an opponent.";
+    case "IN_PROGRESS":
+      if (myTurn) {
+        return `Your move (${me})`;
+      }
+      return g.currentPlayer ? `${g.currentPlayer.playerName} is thinking…` : "Waiting…";
+    default:
+      if (g.event === "PLAYER_LEAVED") {
+        return "Your opponent left the game.";
+      }
+      if (g.winner) {
+        return g.winner === player.playerName && me ? "You won!" : `${g.winner} won.`;
+      }
+      return "It's a draw!";
+  }
+}
+
+// Cells of every completed line, like the server's renderer.
+function winningCells(board, size, winLength) {
+  const cells = new Set();
+  const dirs = [[0, 1], [1, 0], [1, 1], [1, -1]];
+  for (let r = 0; r < size; r++) {
+    for (let c = 0; c < size; c++) {
+      const first = board[r * size + c];
+      if (!first) {
+        continue;
+      }
+      for (const [dr, dc] of dirs) {
+        const endR = r + dr * (winLength - 1);
+        const endC = c + dc * (winLength - 1);
+        if (endR < 0 || endR >= size || endC < 0 || endC >= size) {
+          continue;
+        }
+        let line = [];
+        for (let k = 0; k < winLength && board[(r + dr * k) * size + c + dc * k] === first; k++) {
+          line.push((r + dr * k) * size + c + dc * k);
+        }
+        if (line.length === winLength) {
+          line.forEach((i) => cells.add(i));
+        }
+      }
+    }
+  }
+  return cells;
+}
+
+function move(position) {
+  if (socket && socket.readyState === WebSocket.OPEN) {
+    socket.send(JSON.stringify({ type: "move", position }));
+  }
+}
+
+$("join-game").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  try {
+    // The new state arrives over the socket.
+    const code = linkCode();
+    if (code) {
+      await api("POST", `/v1/codes/${encodeURIComponent(code)}/join`, {});
+    } else {
+      await api("POST", `/v1/games/${encodeURIComponent(game.id)}/join`, { password: $("join-password").value });
+    }
+  } catch (err) {
+    showError(err);
+  }
+});
+
+$("copy-link").addEventListener("click", () => {
+  navigator.clipboard.writeText($("invite-link").value);
+});
+
+$("leave").addEventListener("click", async () => {
+  try {
+    await api("POST", `/v1/games/${encodeURIComponent(game.id)}/leave`);
+  } catch (err) {
+    showError(err);
+  }
+  navigate("/");
+});
+
+$("new-game").addEventListener("click", () => navigate("/"));
+
+window.addEventListener("popstate", route);
+fillShapes();
+route();
@@ -0,0 +1,192 @@
+package httpserver
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"context"
+	"encoding/json"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The WebSocket protocol at /v1/games/{game_id}/ws exchanges JSON text frames
+// with a "type" field:
+//
+//	server: {"type":"state","game":{...}}   game in the proto-JSON of GameData
+//	server: {"type":"error","error":{...}}  status with code and message
+//	both:   {"type":"ping"} and {"type":"pong"}
+//	client: {"type":"move","position":4}
+//
+// Browsers cannot set headers on WebSockets, so the player id may also be
+// passed as the token query parameter. A client that reconnects passes the
+// version of the last state it received, and gets the current state only if
+// it has missed updates.
+const (
+	// Pings are sent this often, and a client silent for longer than
+	// socketReadTimeout is disconnected.
+	socketPingPeriod  = 25 * time.Second
+	socketReadTimeout = 60 * time.Second
+	socketWriteTime   = 10 * time.Second
+)
+
+type socketFrame struct {
+	Type     string          `json:"type"`
+	Game     json.RawMessage `json:"game,omitempty"`
+	Error    json.RawMessage `json:"error,omitempty"`
+	Position *int32          `json:"position,omitempty"`
+}
+
+// socket upgrades an authenticated request to a WebSocket streaming the game.
+func (g *gateway) socket(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token = r.URL.Query().Get("token")
+	}
+	player, exists := g.gameServer.GetPlayer(strings.TrimSpace(token))
+	if !exists {
+		writeError(w, status.Error(codes.Unauthenticated, "invalid player-id"))
+		return
+	}
+
+	version := int64(-1)
+	if v := r.URL.Query().Get("version"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeError(w, status.Error(codes.InvalidArgument, "invalid version"))
+			return
+		}
+		version = n
+	}
+
+	gameID := r.PathValue("game_id")
+	updates, snapshot, err := g.gameServer.Subscribe(r.Context(), gameID, player.ID, version)
+	if err != nil {
+		writeError(w, status.Error(codes.NotFound, err.Error()))
+		return
+	}
+
+	// Same authentication as the other endpoints for commands sent over the socket
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("player-id", player.ID))
+
+	// Origins are not checked, the token already proves who the client is.
+	websocket.Server{Handler: func(ws *websocket.Conn) {
+		s := &socketConn{ws: ws, version: version}
+		s.serve(ctx, g, gameID, updates, snapshot)
+	}}.ServeHTTP(w, r)
+}
+
+// socketConn serializes writes to a WebSocket and remembers the version of
+// the last state sent, so updates are never sent twice or out of order.
+type socketConn struct {
+	ws      *websocket.Conn
+	mu      sync.Mutex
+	version int64
+}
+
+func (s *socketConn) serve(ctx context.Context, g *gateway, gameID string, updates <-chan *tictactoev1.GameData, snapshot *tictactoev1.GameData) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer s.ws.Close()
+
+	if snapshot != nil {
+		if err := s.sendState(snapshot); err != nil {
+			return
+		}
+	}
+
+	go func() {
+		defer cancel()
+		s.readCommands(ctx, g, gameID)
+	}()
+
+	ping := time.NewTicker(socketPingPeriod)
+	defer ping.Stop()
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			if err := s.sendState(update); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := s.send(socketFrame{Type: "ping"}); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// readCommands handles the client's frames until the connection fails or
+// goes silent.
+func (s *socketConn) readCommands(ctx context.Context, g *gateway, gameID string) {
+	for {
+		s.ws.SetReadDeadline(time.Now().Add(socketReadTimeout))
+		var data []byte
+		if err := websocket.Message.Receive(s.ws, &data); err != nil {
+			return
+		}
+		var in socketFrame
+		if err := json.Unmarshal(data, &in); err != nil {
+			s.sendError(status.Error(codes.InvalidArgument, "invalid frame"))
+			continue
+		}
+
+		switch in.Type {
+		case "ping":
+			s.send(socketFrame{Type: "pong"})
+		case "pong":
+		case "move":
+			if in.Position == nil {
+				s.sendError(status.Error(codes.InvalidArgument, "missing position"))
+				continue
+			}
+			// The new state reaches the client through the update channel.
+			_, err := g.call(ctx, "MakeMove", &tictactoev1.MoveRequest{GameId: gameID, Position: *in.Position})
+			if err != nil {
+				s.sendError(err)
+			}
+		default:
+			s.sendError(status.Error(codes.InvalidArgument, "unknown frame type "+strconv.Quote(in.Type)))
+		}
+	}
+}
+
+func (s *socketConn) sendState(update *tictactoev1.GameData) error {
+	body, err := marshalOptions.Marshal(update)
+	if err != nil {
+		return err
+	}
+	return s.sendVersion(socketFrame{Type: "state", Game: body}, update.Version)
+}
+
+func (s *socketConn) sendError(err error) error {
+	body, _ := marshalOptions.Marshal(status.Convert(err).Proto())
+	return s.send(socketFrame{Type: "error", Error: body})
+}
+
+func (s *socketConn) send(f socketFrame) error {
+	return s.sendVersion(f, 0)
+}
+
+func (s *socketConn) sendVersion(f socketFrame, version int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if version != 0 {
+		if version <= s.version {
+			return nil
+		}
+		s.version = version
+	}
+	s.ws.SetWriteDeadline(time.Now().Add(socketWriteTime))
+	return websocket.JSON.Send(s.ws, f)
+}
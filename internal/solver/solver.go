@@ -0,0 +1,304 @@
+package solver
+
+import (
+	"TicTacToe/internal/canon"
+	"errors"
+	"sync"
+)
+
+type Outcome int
+
+const (
+	Draw Outcome = iota
+	Win
+	Loss
+)
+
+// Result is the value of a position for the side to move, assuming perfect play.
+// Distance is the number of plies until the game ends.
+type Result struct {
+	Outcome  Outcome
+	Distance int
+}
+
+// Move is the evaluation of a single empty cell for the side to move.
+type Move struct {
+	Position int
+	Result   Result
+}
+
+// Solver is a memoized negamax solver for k-in-a-row games on a square board.
+// Positions that are rotations or reflections of each other share one table entry.
+type Solver struct {
+	size      int
+	winLength int
+	lines     [][]int
+	zobrist   *canon.Zobrist
+	symmetric bool
+	table     *canon.Table[Result]
+	nodes     uint64
+	mu        sync.Mutex
+}
+
+// Options tune the transposition table of a Solver.
+type Options struct {
+	// TableBits is the log2 of the number of table slots.
+	TableBits int
+	// NoSymmetry keys the table by the board as is, without canonicalization.
+	NoSymmetry bool
+}
+
+var DefaultOptions = Options{TableBits: 16}
+
+func New(size, winLength int) *Solver {
+	return NewWithOptions(size, winLength, DefaultOptions)
+}
+
+func NewWithOptions(size, winLength int, opts Options) *Solver {
+	return &Solver{
+		size:      size,
+		winLength: winLength,
+		lines:     winningLines(size, winLength),
+		zobrist:   canon.NewZobrist(canon.NewGeometry(size, size), 1),
+		symmetric: !opts.NoSymmetry,
+		table:     canon.NewTable[Result](opts.TableBits),
+	}
+}
+
+// Evaluate returns the result of playing each empty cell of the board.
+// Cells are listed in board order.
+func (s *Solver) Evaluate(board []string) ([]Move, error) {
+	cells, err := s.decode(board)
+	if err != nil {
+		return nil, err
+	}
+	if s.winner(cells) != empty {
+		return nil, errors.New("game is already over")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	toMove := sideToMove(cells)
+	hashes := s.zobrist.Hash(cells)
+	var moves []Move
+	for i, c := range cells {
+		if c != empty {
+			continue
+		}
+		s.play(cells, hashes, i, toMove)
+		moves = append(moves, Move{Position: i, Result: flip(s.solve(cells, hashes, other(toMove)))})
+		s.play(cells, hashes, i, empty)
+	}
+
+	return moves, nil
+}
+
+// Solve returns the value of the board for the side to move.
+func (s *Solver) Solve(board []string) (Result, error) {
+	cells, err := s.decode(board)
+	if err != nil {
+		return Result{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.solve(cells, s.zobrist.Hash(cells), sideToMove(cells)), nil
+}
+
+// Nodes returns the number of positions searched so far.
+func (s *Solver) Nodes() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.nodes
+}
+
+func (s *Solver) solve(cells []byte, hashes canon.Hashes, toMove byte) Result {
+	key := hashes[0]
+	if s.symmetric {
+		key = hashes.Canonical()
+	}
+	if r, ok := s.table.Get(key); ok {
+		return r
+	}
+	s.nodes++
+
+	var best Result
+	switch {
+	case s.winner(cells) != empty:
+		// The previous move completed a line.
+		best = Result{Outcome: Loss}
+	case isFull(cells):
+		best = Result{Outcome: Draw}
+	default:
+		first := true
+		for i, c := range cells {
+			if c != empty {
+				continue
+			}
+			s.play(cells, hashes, i, toMove)
+			r := flip(s.solve(cells, hashes, other(toMove)))
+			s.play(cells, hashes, i, empty)
+			if first || Better(r, best) {
+				best = r
+				first = false
+			}
+			if best.Outcome == Win && best.Distance == 1 {
+				break
+			}
+		}
+	}
+
+	s.table.Put(key, best)
+	return best
+}
+
+// play puts a piece on an empty cell, or clears it when piece is empty,
+// keeping the hashes in sync.
+func (s *Solver) play(cells []byte, hashes canon.Hashes, cell int, piece byte) {
+	if piece == empty {
+		s.zobrist.Toggle(hashes, cell, cells[cell])
+	} else {
+		s.zobrist.Toggle(hashes, cell, piece)
+	}
+	cells[cell] = piece
+}
+
+func (s *Solver) decode(board []string) ([]byte, error) {
+	if len(board) != s.size*s.size {
+		return nil, errors.New("invalid board size")
+	}
+	cells := make([]byte, len(board))
+	var xCount, oCount int
+	for i, v := range board {
+		switch v {
+		case "":
+			cells[i] = empty
+		case "X":
+			cells[i] = x
+			xCount++
+		case "O":
+			cells[i] = o
+			oCount++
+		default:
+			return nil, errors.New("invalid cell value")
+		}
+	}
+	if xCount != oCount && xCount != oCount+1 {
+		return nil, errors.New("invalid move count")
+	}
+	return cells, nil
+}
+
+func (s *Solver) winner(cells []byte) byte {
+	for _, line := range s.lines {
+		first := cells[line[0]]
+		if first == empty {
+			continue
+		}
+		won := true
+		for _, i := range line[1:] {
+			if cells[i] != first {
+				won = false
+				break
+			}
+		}
+		if won {
+			return first
+		}
+	}
+	return empty
+}
+
+const (
+	empty byte = '.'
+	x     byte = 'X'
+	o     byte = 'O'
+)
+
+func other(p byte) byte {
+	if p == x {
+		return o
+	}
+	return x
+}
+
+func sideToMove(cells []byte) byte {
+	var xCount, oCount int
+	for _, c := range cells {
+		switch c {
+		case x:
+			xCount++
+		case o:
+			oCount++
+		}
+	}
+	if xCount > oCount {
+		return o
+	}
+	return x
+}
+
+func isFull(cells []byte) bool {
+	for _, c := range cells {
+		if c == empty {
+			return false
+		}
+	}
+	return true
+}
+
+// flip converts a child's result into the parent's point of view.
+func flip(r Result) Result {
+	switch r.Outcome {
+	case Win:
+		r.Outcome = Loss
+	case Loss:
+		r.Outcome = Win
+	}
+	r.Distance++
+	return r
+}
+
+// Better prefers quick wins, then draws, then slow losses.
+func Better(a, b Result) bool {
+	rank := func(o Outcome) int {
+		switch o {
+		case Win:
+			return 2
+		case Draw:
+			return 1
+		}
+		return 0
+	}
+	if rank(a.Outcome) != rank(b.Outcome) {
+		return rank(a.Outcome) > rank(b.Outcome)
+	}
+	if a.Outcome == Win {
+		return a.Distance < b.Distance
+	}
+	return a.Distance > b.Distance
+}
+
+func winningLines(size, winLength int) [][]int {
+	var lines [][]int
+	dirs := [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+	for r := 0; r < size; r++ {
+		for c := 0; c < size; c++ {
+			for _, d := range dirs {
+				endR, endC := r+d[0]*(winLength-1), c+d[1]*(winLength-1)
+				if endR < 0 || endR >= size || endC < 0 || endC >= size {
+					continue
+				}
+				line := make([]int, winLength)
+				for k := range line {
+					line[k] = (r+d[0]*k)*size + c + d[1]*k
+				}
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
@@ -0,0 +1,47 @@
+package solver
+
+import (
+	"TicTacToe/internal/game"
+	"testing"
+)
+
+// 4x4 openings, two stones each
+var benchPositions = []struct {
+	name     string
+	position string
+}{
+	{"center", "4/1x2/2o1/4 x 4 -"},
+	{"adjacent", "4/1xo1/4/4 x 4 -"},
+	{"corners", "x3/4/4/3o x 4 -"},
+	{"edges", "4/x3/4/2o1 x 4 -"},
+}
+
+func BenchmarkSolvePlain(b *testing.B) {
+	benchmarkSolve(b, Options{TableBits: 21, NoSymmetry: true})
+}
+
+func BenchmarkSolveSymmetric(b *testing.B) {
+	benchmarkSolve(b, Options{TableBits: 21})
+}
+
+// benchmarkSolve solves each position from scratch with a fresh table on every
+// iteration, and reports the positions searched.
+func benchmarkSolve(b *testing.B, opts Options) {
+	for _, p := range benchPositions {
+		pos, err := game.ParsePosition(p.position)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(p.name, func(b *testing.B) {
+			var nodes uint64
+			for i := 0; i < b.N; i++ {
+				s := NewWithOptions(pos.Size, pos.WinLength, opts)
+				if _, err := s.Solve(pos.Board); err != nil {
+					b.Fatal(err)
+				}
+				nodes = s.Nodes()
+			}
+			b.ReportMetric(float64(nodes), "nodes/op")
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package inmem
+
+import (
+	"TicTacToe/internal/storage"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+)
+
+type FriendStorage struct {
+	friends  map[string]map[string]bool // Friend IDs of each player, both ways
+	requests map[string]map[string]bool // Senders of the requests to each player
+	mu       sync.RWMutex
+}
+
+func NewFriendStorage() storage.FriendStorage {
+	return &FriendStorage{
+		friends:  make(map[string]map[string]bool),
+		requests: make(map[string]map[string]bool),
+	}
+}
+
+func (s *FriendStorage) AddFriendRequest(ctx context.Context, fromID, toID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.requests[toID][fromID] {
+		return errors.New("friend request already sent")
+	}
+	addToSet(s.requests, toID, fromID)
+	return nil
+}
+
+func (s *FriendStorage) DeleteFriendRequest(ctx context.Context, fromID, toID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return removeFromSet(s.requests, toID, fromID)
+}
+
+func (s *FriendStorage) ListFriendRequests(ctx context.Context, toID string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sortedIDs(s.requests[toID])
+}
+
+func (s *FriendStorage) AddFriendship(ctx context.Context, playerID, friendID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.friends[playerID][friendID] {
+		return errors.New("players are already friends")
+	}
+	addToSet(s.friends, playerID, friendID)
+	addToSet(s.friends, friendID, playerID)
+	return nil
+}
+
+func (s *FriendStorage) RemoveFriendship(ctx context.Context, playerID, friendID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removeFromSet(s.friends, friendID, playerID)
+	return removeFromSet(s.friends, playerID, friendID)
+}
+
+func (s *FriendStorage) AreFriends(ctx context.Context, playerID, friendID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.friends[playerID][friendID]
+}
+
+func (s *FriendStorage) ListFriends(ctx context.Context, playerID string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sortedIDs(s.friends[playerID])
+}
+
+func addToSet(sets map[string]map[string]bool, key, id string) {
+	if sets[key] == nil {
+		sets[key] = make(map[string]bool)
+	}
+	sets[key][id] = true
+}
+
+func removeFromSet(sets map[string]map[string]bool, key, id string) bool {
+	if !sets[key][id] {
+		return false
+	}
+	delete(sets[key], id)
+	if len(sets[key]) == 0 {
+		delete(sets, key)
+	}
+	return true
+}
+
+// sortedIDs returns the IDs of a set in a stable order.
+func sortedIDs(set map[string]bool) []string {
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
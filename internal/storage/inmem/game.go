@@ -86,3 +86,16 @@ func (s *GameStorage) DeleteGame(ctx context.Context, gameID string) error {
 	delete(s.games, gameID)
 	return nil
 }
+
+func (s *GameStorage) ListPlayerGames(ctx context.Context, playerID string) []*game.Game {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var games []*game.Game
+	for _, g := range s.games {
+		if (g.PlayerX != nil && g.PlayerX.ID == playerID) || (g.PlayerO != nil && g.PlayerO.ID == playerID) {
+			games = append(games, g)
+		}
+	}
+	return games
+}
@@ -0,0 +1,83 @@
+package inmem
+
+import (
+	"TicTacToe/internal/puzzle"
+	"TicTacToe/internal/storage"
+	"context"
+	"errors"
+	"sync"
+)
+
+type PuzzleStorage struct {
+	puzzles map[string]*puzzle.Puzzle
+	ratings map[string]int
+	mu      sync.RWMutex
+}
+
+func NewPuzzleStorage() storage.PuzzleStorage {
+	return &PuzzleStorage{
+		puzzles: make(map[string]*puzzle.Puzzle),
+		ratings: make(map[string]int),
+	}
+}
+
+func (s *PuzzleStorage) AddPuzzle(ctx context.Context, p *puzzle.Puzzle) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.puzzles[p.ID]; exists {
+		return errors.New("puzzle already exists")
+	}
+
+	s.puzzles[p.ID] = p
+	return nil
+}
+
+func (s *PuzzleStorage) GetPuzzle(ctx context.Context, puzzleID string) (*puzzle.Puzzle, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, exists := s.puzzles[puzzleID]
+
+	return p, exists
+}
+
+func (s *PuzzleStorage) ListPuzzles(ctx context.Context) []*puzzle.Puzzle {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	puzzles := make([]*puzzle.Puzzle, 0, len(s.puzzles))
+	for _, p := range s.puzzles {
+		puzzles = append(puzzles, p)
+	}
+	return puzzles
+}
+
+func (s *PuzzleStorage) UpdatePuzzle(ctx context.Context, p *puzzle.Puzzle) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.puzzles[p.ID]; !exists {
+		return errors.New("puzzle not found")
+	}
+
+	s.puzzles[p.ID] = p
+	return nil
+}
+
+func (s *PuzzleStorage) GetRating(ctx context.Context, playerID string) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rating, exists := s.ratings[playerID]
+
+	return rating, exists
+}
+
+func (s *PuzzleStorage) SetRating(ctx context.Context, playerID string, rating int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ratings[playerID] = rating
+	return nil
+}
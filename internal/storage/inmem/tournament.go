@@ -0,0 +1,64 @@
+package inmem
+
+import (
+	"TicTacToe/internal/storage"
+	"TicTacToe/internal/tournament"
+	"context"
+	"errors"
+	"sync"
+)
+
+type TournamentStorage struct {
+	tournaments map[string]*tournament.Tournament
+	mu          sync.RWMutex
+}
+
+func NewTournamentStorage() storage.TournamentStorage {
+	return &TournamentStorage{
+		tournaments: make(map[string]*tournament.Tournament),
+	}
+}
+
+func (s *TournamentStorage) CreateTournament(ctx context.Context, t *tournament.Tournament) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.tournaments[t.ID]; exists {
+		return errors.New("tournament already exists")
+	}
+
+	s.tournaments[t.ID] = t
+	return nil
+}
+
+func (s *TournamentStorage) GetTournament(ctx context.Context, tournamentID string) (*tournament.Tournament, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, exists := s.tournaments[tournamentID]
+
+	return t, exists
+}
+
+func (s *TournamentStorage) UpdateTournament(ctx context.Context, t *tournament.Tournament) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.tournaments[t.ID]; !exists {
+		return errors.New("tournament not found")
+	}
+
+	s.tournaments[t.ID] = t
+	return nil
+}
+
+func (s *TournamentStorage) ListTournaments(ctx context.Context) []*tournament.Tournament {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tournaments := make([]*tournament.Tournament, 0, len(s.tournaments))
+	for _, t := range s.tournaments {
+		tournaments = append(tournaments, t)
+	}
+	return tournaments
+}
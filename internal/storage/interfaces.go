@@ -2,6 +2,8 @@ package storage
 
 import (
 	"TicTacToe/internal/game"
+	"TicTacToe/internal/puzzle"
+	"TicTacToe/internal/tournament"
 	"context"
 )
 
@@ -12,4 +14,37 @@ type GameStorage interface {
 	GetGame(ctx context.Context, gameID string) (*game.Game, bool)
 	UpdateGame(ctx context.Context, game *game.Game) error
 	DeleteGame(ctx context.Context, gameID string) error
+	// ListPlayerGames returns the games a player is seated in.
+	ListPlayerGames(ctx context.Context, playerID string) []*game.Game
+}
+
+type PuzzleStorage interface {
+	AddPuzzle(ctx context.Context, p *puzzle.Puzzle) error
+	GetPuzzle(ctx context.Context, puzzleID string) (*puzzle.Puzzle, bool)
+	ListPuzzles(ctx context.Context) []*puzzle.Puzzle
+	UpdatePuzzle(ctx context.Context, p *puzzle.Puzzle) error
+	GetRating(ctx context.Context, playerID string) (int, bool)
+	SetRating(ctx context.Context, playerID string, rating int) error
+}
+
+// FriendStorage keeps friendships, which are mutual, and the friend requests
+// waiting for an answer.
+type FriendStorage interface {
+	AddFriendRequest(ctx context.Context, fromID, toID string) error
+	// DeleteFriendRequest reports whether the request existed.
+	DeleteFriendRequest(ctx context.Context, fromID, toID string) bool
+	// ListFriendRequests returns the IDs of the players who asked toID to be friends.
+	ListFriendRequests(ctx context.Context, toID string) []string
+	AddFriendship(ctx context.Context, playerID, friendID string) error
+	// RemoveFriendship reports whether the players were friends.
+	RemoveFriendship(ctx context.Context, playerID, friendID string) bool
+	AreFriends(ctx context.Context, playerID, friendID string) bool
+	ListFriends(ctx context.Context, playerID string) []string
+}
+
+type TournamentStorage interface {
+	CreateTournament(ctx context.Context, t *tournament.Tournament) error
+	GetTournament(ctx context.Context, tournamentID string) (*tournament.Tournament, bool)
+	UpdateTournament(ctx context.Context, t *tournament.Tournament) error
+	ListTournaments(ctx context.Context) []*tournament.Tournament
 }
@@ -0,0 +1,153 @@
+package tournament
+
+import (
+	"TicTacToe/internal/game"
+	"math/bits"
+	"slices"
+)
+
+// roundRobinPairs pairs a round with the circle method: the first player
+// stays in place while the others rotate, so everyone meets once. With an
+// odd number of players, the one paired with nil has a bye.
+func roundRobinPairs(players []*game.Player, round int) [][2]*game.Player {
+	circle := slices.Clone(players)
+	if len(circle)%2 == 1 {
+		circle = append(circle, nil)
+	}
+	n := len(circle)
+	shift := (round - 1) % (n - 1)
+	rest := circle[1:]
+	rotated := append(slices.Clone(rest[len(rest)-shift:]), rest[:len(rest)-shift]...)
+	circle = append(circle[:1:1], rotated...)
+
+	pairs := make([][2]*game.Player, 0, n/2)
+	for i := 0; i < n/2; i++ {
+		x, o := circle[i], circle[n-1-i]
+		// Alternate colors, or the first player would always be X
+		if (round+i)%2 == 0 {
+			x, o = o, x
+		}
+		pairs = append(pairs, [2]*game.Player{x, o})
+	}
+	return pairs
+}
+
+// swissPairs pairs players with similar scores who haven't met yet. With an
+// odd number of players, the lowest ranked player without a bye gets one.
+func (t *Tournament) swissPairs() [][2]*game.Player {
+	var ranked []*game.Player
+	for _, s := range t.Standings() {
+		ranked = append(ranked, s.Player)
+	}
+
+	var pairs [][2]*game.Player
+	if len(ranked)%2 == 1 {
+		bye := len(ranked) - 1
+		for i := len(ranked) - 1; i >= 0; i-- {
+			if !t.hadBye(ranked[i].ID) {
+				bye = i
+				break
+			}
+		}
+		pairs = append(pairs, [2]*game.Player{ranked[bye], nil})
+		ranked = slices.Delete(ranked, bye, bye+1)
+	}
+
+	paired, ok := pairUp(ranked, t.played)
+	if !ok {
+		// Everyone has met everyone they could be paired with, allow rematches
+		paired, _ = pairUp(ranked, func(a, b string) bool { return false })
+	}
+	for _, p := range paired {
+		// Whoever played X less often gets X, the higher ranked player if even
+		if t.timesX(p[1].ID) < t.timesX(p[0].ID) {
+			p[0], p[1] = p[1], p[0]
+		}
+		pairs = append(pairs, p)
+	}
+	return pairs
+}
+
+// pairUp pairs each player with the highest ranked player below them they
+// haven't played, backtracking when that leaves others without an opponent.
+func pairUp(players []*game.Player, played func(a, b string) bool) ([][2]*game.Player, bool) {
+	if len(players) == 0 {
+		return nil, true
+	}
+	first := players[0]
+	for i := 1; i < len(players); i++ {
+		if played(first.ID, players[i].ID) {
+			continue
+		}
+		rest := append(slices.Clone(players[1:i]), players[i+1:]...)
+		if pairs, ok := pairUp(rest, played); ok {
+			return append([][2]*game.Player{{first, players[i]}}, pairs...), true
+		}
+	}
+	return nil, false
+}
+
+// knockoutPairs pairs the first round by seed, so the top seeds can only meet
+// late, and later rounds by the winners of neighbouring matches. Top seeds get
+// the byes when the number of players isn't a power of two.
+func (t *Tournament) knockoutPairs() [][2]*game.Player {
+	var pairs [][2]*game.Player
+	if t.Round == 1 {
+		size := 1 << bits.Len(uint(len(t.Players)-1))
+		order := bracketOrder(size)
+		seat := func(seed int) *game.Player {
+			if seed < len(t.Players) {
+				return t.Players[seed]
+			}
+			return nil
+		}
+		for i := 0; i < size; i += 2 {
+			pairs = append(pairs, [2]*game.Player{seat(order[i]), seat(order[i+1])})
+		}
+		return pairs
+	}
+
+	previous := t.roundMatches(t.Round - 1)
+	for i := 0; i+1 < len(previous); i += 2 {
+		pairs = append(pairs, [2]*game.Player{previous[i].Winner, previous[i+1].Winner})
+	}
+	return pairs
+}
+
+// bracketOrder lists seeds in bracket order, such as 0 7 3 4 1 6 2 5 for
+// eight players, so neighbours are paired and the best seeds meet last.
+func bracketOrder(size int) []int {
+	order := []int{0}
+	for len(order) < size {
+		next := make([]int, 0, 2*len(order))
+		for _, seed := range order {
+			next = append(next, seed, 2*len(order)-1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// played reports whether two players have met in a match.
+func (t *Tournament) played(a, b string) bool {
+	return slices.ContainsFunc(t.Matches, func(m *Match) bool {
+		return m.O != nil && (m.X.ID == a && m.O.ID == b || m.X.ID == b && m.O.ID == a)
+	})
+}
+
+func (t *Tournament) hadBye(playerID string) bool {
+	return slices.ContainsFunc(t.Matches, func(m *Match) bool {
+		return m.O == nil && m.X.ID == playerID
+	})
+}
+
+// timesX counts the matches a player started as X.
+func (t *Tournament) timesX(playerID string) int {
+	n := 0
+	for _, m := range t.Matches {
+		if m.O != nil && m.X.ID == playerID {
+			n++
+		}
+	}
+	return n
+}
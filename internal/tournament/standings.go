@@ -0,0 +1,130 @@
+package tournament
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"cmp"
+	"slices"
+)
+
+// A bye scores like a won match. The tiebreaks count it as a win against a
+// virtual opponent who scored as much.
+const byeScore = 1.0
+
+// Standing is the result of a player so far. A won match scores 1, a drawn
+// match 0.5, and a bye byeScore.
+type Standing struct {
+	Player *game.Player
+	Score  float64
+	// Sum of the scores of the opponents, including the virtual opponent of
+	// a bye
+	Buchholz float64
+	// Sum of the scores of the opponents beaten, plus half of those drawn
+	SonnebornBerger float64
+	Wins            int
+	Draws           int
+	Losses          int
+	Eliminated      bool // Lost a knockout match
+}
+
+// Standings ranks the players by score, then Buchholz, then
+// Sonneborn-Berger, then seed. In a knockout, players still in the
+// tournament come first.
+func (t *Tournament) Standings() []Standing {
+	standings := make(map[string]*Standing, len(t.Players))
+	for _, p := range t.Players {
+		standings[p.ID] = &Standing{Player: p}
+	}
+
+	for _, m := range t.Matches {
+		if !m.Finished {
+			continue
+		}
+		x := standings[m.X.ID]
+		if m.O == nil {
+			x.Score += byeScore
+			continue
+		}
+		o := standings[m.O.ID]
+		switch m.Winner {
+		case nil:
+			x.Score += 0.5
+			o.Score += 0.5
+			x.Draws++
+			o.Draws++
+		case m.X:
+			x.Score++
+			x.Wins++
+			o.Losses++
+			o.Eliminated = t.Format == tictactoev1.TournamentFormat_KNOCKOUT
+		default:
+			o.Score++
+			o.Wins++
+			x.Losses++
+			x.Eliminated = t.Format == tictactoev1.TournamentFormat_KNOCKOUT
+		}
+	}
+
+	// Tiebreaks need the final scores of the opponents
+	for _, m := range t.Matches {
+		if !m.Finished {
+			continue
+		}
+		if m.O == nil {
+			x := standings[m.X.ID]
+			x.Buchholz += byeScore
+			x.SonnebornBerger += byeScore
+			continue
+		}
+		x, o := standings[m.X.ID], standings[m.O.ID]
+		x.Buchholz += o.Score
+		o.Buchholz += x.Score
+		switch m.Winner {
+		case nil:
+			x.SonnebornBerger += o.Score / 2
+			o.SonnebornBerger += x.Score / 2
+		case m.X:
+			x.SonnebornBerger += o.Score
+		default:
+			o.SonnebornBerger += x.Score
+		}
+	}
+
+	ranked := make([]Standing, 0, len(t.Players))
+	for _, p := range t.Players {
+		ranked = append(ranked, *standings[p.ID])
+	}
+	slices.SortStableFunc(ranked, func(a, b Standing) int {
+		if a.Eliminated != b.Eliminated {
+			if a.Eliminated {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(b.Buchholz, a.Buchholz),
+			cmp.Compare(b.SonnebornBerger, a.SonnebornBerger),
+		)
+	})
+	return ranked
+}
+
+// StandingsToProto converts the tournament with its matches and standings.
+func StandingsToProto(t *Tournament) *tictactoev1.Standings {
+	standings := &tictactoev1.Standings{Tournament: ToProto(t, true)}
+	for i, s := range t.Standings() {
+		standings.Standings = append(standings.Standings, &tictactoev1.Standing{
+			Rank:            int32(i + 1),
+			Player:          game.PlayerToProto(s.Player),
+			Score:           s.Score,
+			Buchholz:        s.Buchholz,
+			SonnebornBerger: s.SonnebornBerger,
+			Wins:            int32(s.Wins),
+			Draws:           int32(s.Draws),
+			Losses:          int32(s.Losses),
+			Eliminated:      s.Eliminated,
+		})
+	}
+	return standings
+}
@@ -0,0 +1,37 @@
+package tournament
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"testing"
+)
+
+func TestByeInStandings(t *testing.T) {
+	a, b, c := &game.Player{ID: "a"}, &game.Player{ID: "b"}, &game.Player{ID: "c"}
+	tr := &Tournament{
+		Format:  tictactoev1.TournamentFormat_SWISS,
+		Players: []*game.Player{a, b, c},
+		Matches: []*Match{
+			{Round: 1, X: a, O: b, Finished: true, Winner: a},
+			{Round: 1, X: c, Finished: true},
+			{Round: 2, X: c, O: a, Finished: true},
+			{Round: 2, X: b, Finished: true},
+		},
+	}
+
+	want := map[string]Standing{
+		// Beat b (1 point), drew c (1.5 points)
+		"a": {Score: 1.5, Buchholz: 2.5, SonnebornBerger: 1.75},
+		// Lost to a (1.5 points), bye
+		"b": {Score: 1, Buchholz: 1.5 + byeScore, SonnebornBerger: byeScore},
+		// Bye, drew a (1.5 points)
+		"c": {Score: 1.5, Buchholz: byeScore + 1.5, SonnebornBerger: byeScore + 0.75},
+	}
+	for _, s := range tr.Standings() {
+		w := want[s.Player.ID]
+		if s.Score != w.Score || s.Buchholz != w.Buchholz || s.SonnebornBerger != w.SonnebornBerger {
+			t.Errorf("%s: score %v, Buchholz %v, Sonneborn-Berger %v; want %v, %v, %v",
+				s.Player.ID, s.Score, s.Buchholz, s.SonnebornBerger, w.Score, w.Buchholz, w.SonnebornBerger)
+		}
+	}
+}
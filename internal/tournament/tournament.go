@@ -0,0 +1,390 @@
+// Package tournament pairs the players of a tournament round by round and
+// ranks them. Playing the games is up to the caller, which reports every
+// result with RecordGame.
+package tournament
+
+import (
+	tictactoev1 "TicTacToe/api/tictactoe"
+	"TicTacToe/internal/game"
+	"errors"
+	"math/bits"
+	"slices"
+	"time"
+)
+
+var (
+	ErrNotRegistering     = errors.New("tournament has already started")
+	ErrAlreadyRegistered  = errors.New("you are already registered")
+	ErrTooFewPlayers      = errors.New("a tournament needs at least 2 players")
+	ErrInvalidBestOf      = errors.New("matches must have an odd number of games")
+	ErrRegistrationClosed = errors.New("registration is not open")
+	ErrInvalidSchedule    = errors.New("registration must close before the start, and the start can't be in the past")
+)
+
+// Single games played after a drawn knockout match, before the higher seed
+// advances.
+const tiebreakGames = 3
+
+type Tournament struct {
+	ID       string
+	Name     string
+	Format   tictactoev1.TournamentFormat
+	Status   tictactoev1.TournamentStatus
+	Creator  *game.Player
+	Settings game.Settings // Of every game
+	BestOf   int           // Games per match
+	// Rounds to play. Requested for Swiss, otherwise set by Start.
+	Rounds    int
+	Round     int            // Current round, 0 before the start
+	Players   []*game.Player // In registration order, which is also the seeding
+	Matches   []*Match       // Of all rounds so far
+	CreatedAt time.Time
+	Schedule  Schedule
+	// When the current round was paired, for the no-show deadline
+	RoundStartedAt time.Time
+}
+
+// Schedule is when a tournament takes place. Zero times and durations are
+// left out: registration is open until the start, and the creator starts the
+// tournament.
+type Schedule struct {
+	RegistrationOpensAt  time.Time
+	RegistrationClosesAt time.Time
+	StartsAt             time.Time
+	// Players who haven't connected to their game this long after the start
+	// of a round forfeit their match
+	NoShowTimeout time.Duration
+}
+
+// Match is a pairing of a round, played over one or more games.
+type Match struct {
+	Round int
+	X     *game.Player // Plays X in the first game, then colors alternate
+	O     *game.Player // Nil for a bye
+	Games []string     // IDs of the games played so far
+	WinsX int
+	WinsO int
+	Draws int
+	// Finished matches without a winner are drawn
+	Finished bool
+	Winner   *game.Player
+	Forfeit  bool // Ended because a player didn't show up
+}
+
+// New creates a tournament open for registration.
+func New(id, name string, format tictactoev1.TournamentFormat, creator *game.Player, settings game.Settings, bestOf, rounds int, schedule Schedule, now time.Time) (*Tournament, error) {
+	if err := schedule.check(now); err != nil {
+		return nil, err
+	}
+	if bestOf == 0 {
+		bestOf = 1
+	}
+	if bestOf < 0 || bestOf%2 == 0 {
+		return nil, ErrInvalidBestOf
+	}
+	if format != tictactoev1.TournamentFormat_SWISS {
+		rounds = 0
+	}
+	return &Tournament{
+		ID:        id,
+		Name:      name,
+		Format:    format,
+		Status:    tictactoev1.TournamentStatus_REGISTRATION,
+		Creator:   creator,
+		Settings:  settings,
+		BestOf:    bestOf,
+		Rounds:    max(rounds, 0),
+		CreatedAt: now,
+		Schedule:  schedule,
+	}, nil
+}
+
+func (s Schedule) check(now time.Time) error {
+	opens, closes, starts := s.RegistrationOpensAt, s.RegistrationClosesAt, s.StartsAt
+	switch {
+	case s.NoShowTimeout < 0:
+		return ErrInvalidSchedule
+	case !starts.IsZero() && starts.Before(now):
+		return ErrInvalidSchedule
+	case !opens.IsZero() && !closes.IsZero() && !opens.Before(closes):
+		return ErrInvalidSchedule
+	case !closes.IsZero() && !starts.IsZero() && closes.After(starts):
+		return ErrInvalidSchedule
+	case !opens.IsZero() && !starts.IsZero() && !opens.Before(starts):
+		return ErrInvalidSchedule
+	}
+	return nil
+}
+
+// Register adds a player before the tournament starts, while registration
+// is open.
+func (t *Tournament) Register(p *game.Player, now time.Time) error {
+	if t.Status != tictactoev1.TournamentStatus_REGISTRATION {
+		return ErrNotRegistering
+	}
+	opens, closes := t.Schedule.RegistrationOpensAt, t.Schedule.RegistrationClosesAt
+	if !opens.IsZero() && now.Before(opens) || !closes.IsZero() && !now.Before(closes) {
+		return ErrRegistrationClosed
+	}
+	if t.seed(p.ID) >= 0 {
+		return ErrAlreadyRegistered
+	}
+	t.Players = append(t.Players, p)
+	return nil
+}
+
+// Start pairs the first round and returns its matches that need a game.
+func (t *Tournament) Start() ([]*Match, error) {
+	if t.Status != tictactoev1.TournamentStatus_REGISTRATION {
+		return nil, ErrNotRegistering
+	}
+	n := len(t.Players)
+	if n < 2 {
+		return nil, ErrTooFewPlayers
+	}
+
+	switch t.Format {
+	case tictactoev1.TournamentFormat_ROUND_ROBIN:
+		t.Rounds = n + n%2 - 1
+	case tictactoev1.TournamentFormat_SWISS:
+		// Without rematches there are as many rounds as in a round robin
+		if t.Rounds == 0 {
+			t.Rounds = bits.Len(uint(n - 1))
+		}
+		t.Rounds = min(t.Rounds, n+n%2-1)
+	case tictactoev1.TournamentFormat_KNOCKOUT:
+		t.Rounds = bits.Len(uint(n - 1))
+	}
+	t.Status = tictactoev1.TournamentStatus_RUNNING
+	return t.nextRound(), nil
+}
+
+// RecordGame records the result of a game, with an empty winnerID for a
+// draw. It returns the matches that need a new game: the match of the game
+// if it goes on, or the matches of the next round once this one is over.
+func (t *Tournament) RecordGame(gameID, winnerID string) []*Match {
+	m := t.MatchOf(gameID)
+	if m == nil || m.Finished || m.Games[len(m.Games)-1] != gameID {
+		return nil
+	}
+	t.record(m, winnerID)
+	if !m.Finished {
+		return []*Match{m}
+	}
+	return t.matchFinished()
+}
+
+// Forfeit ends a match that hasn't finished because players didn't show up.
+// A single no-show loses the match. When both are missing, the match is drawn,
+// and in a knockout the higher seed advances. It returns the matches that
+// need a game like RecordGame.
+func (t *Tournament) Forfeit(m *Match, noShows ...string) []*Match {
+	if m.Finished || m.O == nil || len(noShows) == 0 {
+		return nil
+	}
+	switch {
+	case len(noShows) == 1 && noShows[0] == m.X.ID:
+		m.finish(m.O)
+	case len(noShows) == 1 && noShows[0] == m.O.ID:
+		m.finish(m.X)
+	case t.Format != tictactoev1.TournamentFormat_KNOCKOUT:
+		m.finish(nil)
+	case t.seed(m.X.ID) < t.seed(m.O.ID):
+		m.finish(m.X)
+	default:
+		m.finish(m.O)
+	}
+	m.Forfeit = true
+	return t.matchFinished()
+}
+
+// RoundMatches returns the matches of the current round.
+func (t *Tournament) RoundMatches() []*Match {
+	return t.roundMatches(t.Round)
+}
+
+// matchFinished pairs the next round, or completes the tournament, once all
+// matches of the round are finished.
+func (t *Tournament) matchFinished() []*Match {
+	for _, other := range t.roundMatches(t.Round) {
+		if !other.Finished {
+			return nil
+		}
+	}
+	if t.Round >= t.Rounds {
+		t.Status = tictactoev1.TournamentStatus_COMPLETED
+		return nil
+	}
+	return t.nextRound()
+}
+
+// NextGame returns who plays X and O in the next game of the match.
+func (m *Match) NextGame() (x, o *game.Player) {
+	if len(m.Games)%2 == 0 {
+		return m.X, m.O
+	}
+	return m.O, m.X
+}
+
+// MatchOf returns the match a game was played in, or nil.
+func (t *Tournament) MatchOf(gameID string) *Match {
+	for _, m := range t.Matches {
+		if slices.Contains(m.Games, gameID) {
+			return m
+		}
+	}
+	return nil
+}
+
+func (t *Tournament) record(m *Match, winnerID string) {
+	switch winnerID {
+	case m.X.ID:
+		m.WinsX++
+	case m.O.ID:
+		m.WinsO++
+	default:
+		m.Draws++
+	}
+
+	needed := t.BestOf/2 + 1
+	played := len(m.Games)
+	switch {
+	case m.WinsX >= needed:
+		m.finish(m.X)
+	case m.WinsO >= needed:
+		m.finish(m.O)
+	case played < t.BestOf:
+	case m.WinsX > m.WinsO:
+		m.finish(m.X)
+	case m.WinsO > m.WinsX:
+		m.finish(m.O)
+	case t.Format != tictactoev1.TournamentFormat_KNOCKOUT:
+		m.finish(nil)
+	case played < t.BestOf+tiebreakGames:
+		// Knockout matches need a winner, so single games are played until one is won
+	case t.seed(m.X.ID) < t.seed(m.O.ID):
+		m.finish(m.X)
+	default:
+		m.finish(m.O)
+	}
+}
+
+func (m *Match) finish(winner *game.Player) {
+	m.Finished = true
+	m.Winner = winner
+}
+
+// nextRound pairs the next round and returns its matches that need a game.
+// Byes are finished right away.
+func (t *Tournament) nextRound() []*Match {
+	t.Round++
+	var pairs [][2]*game.Player
+	switch t.Format {
+	case tictactoev1.TournamentFormat_ROUND_ROBIN:
+		pairs = roundRobinPairs(t.Players, t.Round)
+	case tictactoev1.TournamentFormat_SWISS:
+		pairs = t.swissPairs()
+	case tictactoev1.TournamentFormat_KNOCKOUT:
+		pairs = t.knockoutPairs()
+	}
+
+	var playing []*Match
+	for _, p := range pairs {
+		m := &Match{Round: t.Round, X: p[0], O: p[1]}
+		if m.X == nil {
+			m.X, m.O = m.O, nil
+		}
+		if m.O == nil {
+			m.finish(m.X)
+		} else {
+			playing = append(playing, m)
+		}
+		t.Matches = append(t.Matches, m)
+	}
+	return playing
+}
+
+func (t *Tournament) roundMatches(round int) []*Match {
+	var matches []*Match
+	for _, m := range t.Matches {
+		if m.Round == round {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// seed returns the position of a player in the seeding, or -1.
+func (t *Tournament) seed(playerID string) int {
+	return slices.IndexFunc(t.Players, func(p *game.Player) bool { return p.ID == playerID })
+}
+
+// ToProto converts a tournament, with its matches if withMatches is set.
+func ToProto(t *Tournament, withMatches bool) *tictactoev1.Tournament {
+	pt := &tictactoev1.Tournament{
+		Id:                   t.ID,
+		Name:                 t.Name,
+		Format:               t.Format,
+		Status:               t.Status,
+		Creator:              game.PlayerToProto(t.Creator),
+		Settings:             game.SettingsToProto(t.Settings),
+		BestOf:               int32(t.BestOf),
+		Rounds:               int32(t.Rounds),
+		CurrentRound:         int32(t.Round),
+		RegistrationOpensAt:  unixTime(t.Schedule.RegistrationOpensAt),
+		RegistrationClosesAt: unixTime(t.Schedule.RegistrationClosesAt),
+		StartsAt:             unixTime(t.Schedule.StartsAt),
+		NoShowMinutes:        int32(t.Schedule.NoShowTimeout / time.Minute),
+		RoundStartedAt:       unixTime(t.RoundStartedAt),
+	}
+	for _, p := range t.Players {
+		pt.Players = append(pt.Players, game.PlayerToProto(p))
+	}
+	if withMatches {
+		for _, m := range t.Matches {
+			pt.Matches = append(pt.Matches, MatchToProto(m))
+		}
+	}
+	return pt
+}
+
+func MatchToProto(m *Match) *tictactoev1.TournamentMatch {
+	return &tictactoev1.TournamentMatch{
+		Round:    int32(m.Round),
+		PlayerX:  game.PlayerToProto(m.X),
+		PlayerO:  game.PlayerToProto(m.O),
+		GameIds:  m.Games,
+		WinsX:    int32(m.WinsX),
+		WinsO:    int32(m.WinsO),
+		Draws:    int32(m.Draws),
+		Finished: m.Finished,
+		Winner:   game.PlayerToProto(m.Winner),
+		Forfeit:  m.Forfeit,
+	}
+}
+
+// unixTime converts a time to Unix seconds, with 0 for the zero time.
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// ScheduleFromProto reads the schedule of a tournament being created.
+func ScheduleFromProto(req *tictactoev1.CreateTournamentRequest) Schedule {
+	return Schedule{
+		RegistrationOpensAt:  fromUnix(req.GetRegistrationOpensAt()),
+		RegistrationClosesAt: fromUnix(req.GetRegistrationClosesAt()),
+		StartsAt:             fromUnix(req.GetStartsAt()),
+		NoShowTimeout:        time.Duration(req.GetNoShowMinutes()) * time.Minute,
+	}
+}
+
+// fromUnix is the reverse of unixTime.
+func fromUnix(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
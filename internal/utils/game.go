@@ -1,40 +1,41 @@
 package utils
 
-func CheckWin(board []string) string {
-	// Check rows
-	for i := 0; i < 9; i += 3 {
-		if board[i] != "" && board[i] == board[i+1] && board[i] == board[i+2] {
-			return board[i]
+// CheckWin returns the symbol that has winLength in a row on a size x size board.
+func CheckWin(board []string, size, winLength int) string {
+	dirs := [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+	for r := 0; r < size; r++ {
+		for c := 0; c < size; c++ {
+			symbol := board[r*size+c]
+			if symbol == "" {
+				continue
+			}
+			for _, d := range dirs {
+				endR, endC := r+d[0]*(winLength-1), c+d[1]*(winLength-1)
+				if endR < 0 || endR >= size || endC < 0 || endC >= size {
+					continue
+				}
+				won := true
+				for k := 1; k < winLength; k++ {
+					if board[(r+d[0]*k)*size+c+d[1]*k] != symbol {
+						won = false
+						break
+					}
+				}
+				if won {
+					return symbol
+				}
+			}
 		}
 	}
 
-	// Check columns
-	for i := 0; i < 3; i++ {
-		if board[i] != "" && board[i] == board[i+3] && board[i] == board[i+6] {
-			return board[i]
-		}
-	}
-
-	// Check diagonals
-	if board[0] != "" && board[0] == board[4] && board[0] == board[8] {
-		return board[0]
-	}
-	if board[2] != "" && board[2] == board[4] && board[2] == board[6] {
-		return board[2]
-	}
-
 	return ""
 }
 
 func IsBoardFull(board []string) bool {
-	i := 0
 	for _, v := range board {
-		if v != "" {
-			i++
+		if v == "" {
+			return false
 		}
 	}
-	if i == 9 {
-		return true
-	}
-	return false
+	return true
 }
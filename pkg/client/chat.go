@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+)
+
+// ChatStream delivers the chat messages of a game.
+type ChatStream struct {
+	messages chan *tictactoev1.ChatMessage
+	errs     chan error
+	err      error
+}
+
+// Messages returns the chat history, then new messages as they are sent.
+// The channel is closed when the stream ends.
+func (s *ChatStream) Messages() <-chan *tictactoev1.ChatMessage {
+	return s.messages
+}
+
+// Errors reports broken streams that are being reconnected. Errors are dropped
+// while the previous one has not been read.
+func (s *ChatStream) Errors() <-chan error {
+	return s.errs
+}
+
+// Err returns why the stream ended, once Messages is closed. It is nil when
+// the context was cancelled.
+func (s *ChatStream) Err() error {
+	return s.err
+}
+
+// SendChatMessage sends a message to the chat of a game. The returned message
+// has the text as others see it, after the server's filter.
+func (c *Client) SendChatMessage(ctx context.Context, gameID, text string) (*tictactoev1.ChatMessage, error) {
+	return c.api.SendChatMessage(ctx, &tictactoev1.SendChatMessageRequest{GameId: gameID, Text: text})
+}
+
+// SendReaction shows a reaction to everyone following the game. It arrives
+// as a game update with the REACTION event.
+func (c *Client) SendReaction(ctx context.Context, gameID string, reaction tictactoev1.ReactionType) error {
+	_, err := c.api.SendReaction(ctx, &tictactoev1.SendReactionRequest{GameId: gameID, Type: reaction})
+	return err
+}
+
+// SubscribeChat follows the chat of a game until ctx is cancelled,
+// reconnecting like Subscribe. The history is sent again by the server after
+// a reconnect, but messages are only delivered once.
+func (c *Client) SubscribeChat(ctx context.Context, gameID string) *ChatStream {
+	s := &ChatStream{
+		messages: make(chan *tictactoev1.ChatMessage),
+		errs:     make(chan error, 1),
+	}
+	go func() {
+		defer close(s.messages)
+		received := make(map[string]bool)
+		s.err = c.keepAlive(ctx, s.errs, func(reset func()) error {
+			stream, err := c.api.GetChat(ctx, &tictactoev1.GetChatRequest{GameId: gameID})
+			if err != nil {
+				return err
+			}
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				reset()
+				if received[msg.MessageId] {
+					continue
+				}
+				received[msg.MessageId] = true
+				select {
+				case s.messages <- msg:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		})
+	}()
+	return s
+}
@@ -0,0 +1,200 @@
+// Package client is a Go client for the game server. It wraps the gRPC
+// GameService with typed methods, keeps the player's token, and follows
+// games with automatic reconnects:
+//
+//	c, err := client.New("localhost:17077")
+//	if err != nil { ... }
+//	defer c.Close()
+//	player, err := c.Login(ctx, "alice")
+//	g, err := c.CreateGame(ctx, &tictactoev1.CreateGameRequest{Bot: "mcts"})
+//	sub := c.Subscribe(ctx, g.Id)
+//	for update := range sub.Updates() { ... }
+//
+// Errors returned by the methods are gRPC status errors, so their code can be
+// read with status.Code.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultCallTimeout = 10 * time.Second
+	DefaultMinBackoff  = 250 * time.Millisecond
+	DefaultMaxBackoff  = 10 * time.Second
+)
+
+// Client is a connection to the game server, safe for concurrent use.
+type Client struct {
+	conn *grpc.ClientConn
+	api  tictactoev1.GameServiceClient
+	opts options
+
+	mu       sync.RWMutex
+	playerID string
+}
+
+type options struct {
+	dialOptions []grpc.DialOption
+	tlsConfig   *tls.Config
+	playerID    string
+	callTimeout time.Duration
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithDialOptions adds gRPC dial options.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// WithTLS encrypts the connection. A nil config verifies the server against
+// the system's root certificates.
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) {
+		if config == nil {
+			config = &tls.Config{}
+		}
+		o.tlsConfig = config
+	}
+}
+
+// WithPlayerID authenticates as a player that has already logged in.
+func WithPlayerID(playerID string) Option {
+	return func(o *options) {
+		o.playerID = playerID
+	}
+}
+
+// WithCallTimeout limits calls whose context has no deadline. Zero disables
+// the limit.
+func WithCallTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.callTimeout = d
+	}
+}
+
+// WithBackoff sets the delays between reconnects of subscriptions, which
+// double from min up to max.
+func WithBackoff(min, max time.Duration) Option {
+	return func(o *options) {
+		o.minBackoff, o.maxBackoff = min, max
+	}
+}
+
+// New creates a client for the server at addr. The connection is made lazily
+// by the first call, or by Ping. Without WithTLS it is not encrypted.
+func New(addr string, opts ...Option) (*Client, error) {
+	o := options{
+		callTimeout: DefaultCallTimeout,
+		minBackoff:  DefaultMinBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	c := &Client{opts: o, playerID: o.playerID}
+	creds := insecure.NewCredentials()
+	if o.tlsConfig != nil {
+		creds = credentials.NewTLS(o.tlsConfig)
+	}
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(c.authUnary),
+		grpc.WithChainStreamInterceptor(c.authStream),
+	}, o.dialOptions...)
+
+	conn, err := grpc.NewClient(addr, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	c.conn = conn
+	c.api = tictactoev1.NewGameServiceClient(conn)
+	return c, nil
+}
+
+// Close closes the connection. Subscriptions end with an error.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Ping connects to the server and waits until the connection is ready, to
+// check the address and credentials before logging in.
+func (c *Client) Ping(ctx context.Context) error {
+	c.conn.Connect()
+	for {
+		state := c.conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return fmt.Errorf("cannot reach %s", c.conn.Target())
+		}
+		if !c.conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("cannot reach %s: %w", c.conn.Target(), ctx.Err())
+		}
+	}
+}
+
+// PlayerID returns the id of the logged in player, empty before Login.
+func (c *Client) PlayerID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.playerID
+}
+
+// SetPlayerID authenticates further calls as another player.
+func (c *Client) SetPlayerID(playerID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.playerID = playerID
+}
+
+// outgoing adds the player-id metadata the server's auth interceptor expects.
+func (c *Client) outgoing(ctx context.Context) context.Context {
+	if playerID := c.PlayerID(); playerID != "" {
+		return metadata.AppendToOutgoingContext(ctx, "player-id", playerID)
+	}
+	return ctx
+}
+
+func (c *Client) authUnary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok && c.opts.callTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.opts.callTimeout)
+		defer cancel()
+	}
+	return invoker(c.outgoing(ctx), method, req, reply, cc, opts...)
+}
+
+func (c *Client) authStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(c.outgoing(ctx), desc, cc, method, opts...)
+}
+
+// ErrorMessage returns the server's message of a status error, for showing
+// errors to players.
+func ErrorMessage(err error) string {
+	if s, ok := status.FromError(err); ok {
+		return s.Message()
+	}
+	return err.Error()
+}
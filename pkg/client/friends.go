@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+)
+
+// PresenceStream delivers presence changes of the player's friends.
+type PresenceStream struct {
+	updates chan *tictactoev1.Friend
+	errs    chan error
+	err     error
+}
+
+// Updates returns the presence of every friend, then changes as they happen.
+// The presence of every friend is sent again after a reconnect. The channel
+// is closed when the stream ends.
+func (p *PresenceStream) Updates() <-chan *tictactoev1.Friend {
+	return p.updates
+}
+
+// Errors reports broken streams that are being reconnected. Errors are dropped
+// while the previous one has not been read.
+func (p *PresenceStream) Errors() <-chan error {
+	return p.errs
+}
+
+// Err returns why the stream ended, once Updates is closed. It is nil when
+// the context was cancelled.
+func (p *PresenceStream) Err() error {
+	return p.err
+}
+
+// SendFriendRequest asks a player to be friends. It reports whether the
+// player had asked too, which makes them friends right away.
+func (c *Client) SendFriendRequest(ctx context.Context, playerID string) (bool, error) {
+	resp, err := c.api.SendFriendRequest(ctx, &tictactoev1.SendFriendRequestRequest{PlayerId: playerID})
+	if err != nil {
+		return false, err
+	}
+	return resp.Accepted, nil
+}
+
+// AnswerFriendRequest accepts or declines the friend request of a player.
+func (c *Client) AnswerFriendRequest(ctx context.Context, playerID string, accept bool) error {
+	_, err := c.api.AnswerFriendRequest(ctx, &tictactoev1.AnswerFriendRequestRequest{PlayerId: playerID, Accept: accept})
+	return err
+}
+
+// ListFriendRequests returns the players waiting for an answer.
+func (c *Client) ListFriendRequests(ctx context.Context) ([]*tictactoev1.PlayerData, error) {
+	resp, err := c.api.ListFriendRequests(ctx, &tictactoev1.ListFriendRequestsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Players, nil
+}
+
+// RemoveFriend ends a friendship.
+func (c *Client) RemoveFriend(ctx context.Context, playerID string) error {
+	_, err := c.api.RemoveFriend(ctx, &tictactoev1.RemoveFriendRequest{PlayerId: playerID})
+	return err
+}
+
+// ListFriends returns the player's friends with their presence.
+func (c *Client) ListFriends(ctx context.Context) ([]*tictactoev1.Friend, error) {
+	resp, err := c.api.ListFriends(ctx, &tictactoev1.ListFriendsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Friends, nil
+}
+
+// KeepOnline sends heartbeats until ctx is cancelled, so friends see the
+// player as online. Failed heartbeats are retried at the next interval.
+func (c *Client) KeepOnline(ctx context.Context) {
+	interval := 15 * time.Second
+	for {
+		resp, err := c.api.Heartbeat(ctx, &tictactoev1.HeartbeatRequest{})
+		if err == nil && resp.IntervalSeconds > 0 {
+			interval = time.Duration(resp.IntervalSeconds) * time.Second
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// SubscribePresence follows the presence of the player's friends until ctx is
+// cancelled, reconnecting like Subscribe.
+func (c *Client) SubscribePresence(ctx context.Context) *PresenceStream {
+	p := &PresenceStream{
+		updates: make(chan *tictactoev1.Friend),
+		errs:    make(chan error, 1),
+	}
+	go func() {
+		defer close(p.updates)
+		p.err = c.keepAlive(ctx, p.errs, func(reset func()) error {
+			stream, err := c.api.SubscribePresence(ctx, &tictactoev1.SubscribePresenceRequest{})
+			if err != nil {
+				return err
+			}
+			for {
+				update, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				reset()
+				select {
+				case p.updates <- update:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		})
+	}()
+	return p
+}
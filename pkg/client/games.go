@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+)
+
+// Login registers a player and authenticates further calls as that player.
+func (c *Client) Login(ctx context.Context, playerName string) (*tictactoev1.PlayerData, error) {
+	player, err := c.api.Login(ctx, &tictactoev1.LoginRequest{PlayerName: playerName})
+	if err != nil {
+		return nil, err
+	}
+	c.SetPlayerID(player.PlayerId)
+	return player, nil
+}
+
+// CreateGame creates a game with the logged in player as X. With DaysPerMove
+// set it is a correspondence game: the player to move gets YOUR_TURN on their
+// notification stream, and loses on time if they don't move within that many
+// days.
+func (c *Client) CreateGame(ctx context.Context, req *tictactoev1.CreateGameRequest) (*tictactoev1.GameData, error) {
+	return c.api.CreateGame(ctx, req)
+}
+
+// MyActiveGames returns the games where it is the player's turn, live games
+// first, then correspondence games by move deadline.
+func (c *Client) MyActiveGames(ctx context.Context) ([]*tictactoev1.GameData, error) {
+	resp, err := c.api.MyActiveGames(ctx, &tictactoev1.MyActiveGamesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Games, nil
+}
+
+// JoinGame joins a game waiting for its second player. The password is
+// ignored for public games.
+func (c *Client) JoinGame(ctx context.Context, gameID, password string) (*tictactoev1.GameData, error) {
+	return c.api.JoinGame(ctx, &tictactoev1.JoinGameRequest{GameId: gameID, Password: password})
+}
+
+// JoinByCode joins the game a short join code was issued for. The code lets
+// the holder join without the game's password.
+func (c *Client) JoinByCode(ctx context.Context, code string) (*tictactoev1.GameData, error) {
+	return c.api.JoinByCode(ctx, &tictactoev1.JoinByCodeRequest{Code: code})
+}
+
+// Join joins the game a link or code points to. The password is only used
+// when the target carries no join code.
+func (c *Client) Join(ctx context.Context, target JoinTarget, password string) (*tictactoev1.GameData, error) {
+	if target.JoinCode != "" {
+		return c.JoinByCode(ctx, target.JoinCode)
+	}
+	return c.JoinGame(ctx, target.GameID, password)
+}
+
+// GetInvite returns the join code of a game the player created. A new join
+// code is issued if the previous one expired or was revoked.
+func (c *Client) GetInvite(ctx context.Context, gameID string) (*tictactoev1.Invite, error) {
+	return c.api.GetInvite(ctx, &tictactoev1.GetInviteRequest{GameId: gameID})
+}
+
+// RevokeJoinCode stops the join code of a game the player created from working.
+func (c *Client) RevokeJoinCode(ctx context.Context, gameID string) error {
+	_, err := c.api.RevokeJoinCode(ctx, &tictactoev1.RevokeJoinCodeRequest{GameId: gameID})
+	return err
+}
+
+// LeaveGame leaves a game, which ends it.
+func (c *Client) LeaveGame(ctx context.Context, gameID string) (*tictactoev1.GameData, error) {
+	return c.api.LeaveGame(ctx, &tictactoev1.LeaveGameRequest{GameId: gameID})
+}
+
+// MakeMove places the player's piece on a cell.
+func (c *Client) MakeMove(ctx context.Context, gameID string, position int32) (*tictactoev1.GameData, error) {
+	return c.api.MakeMove(ctx, &tictactoev1.MoveRequest{GameId: gameID, Position: position})
+}
+
+// AnalyzePosition evaluates every empty cell of a game or position.
+func (c *Client) AnalyzePosition(ctx context.Context, req *tictactoev1.AnalyzePositionRequest) (*tictactoev1.PositionAnalysis, error) {
+	return c.api.AnalyzePosition(ctx, req)
+}
+
+// ListBots returns the bots that can be passed to CreateGame.
+func (c *Client) ListBots(ctx context.Context) ([]string, error) {
+	resp, err := c.api.ListBots(ctx, &tictactoev1.ListBotsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Names, nil
+}
+
+// GetPuzzle returns a puzzle matching the player's rating.
+func (c *Client) GetPuzzle(ctx context.Context) (*tictactoev1.Puzzle, error) {
+	return c.api.GetPuzzle(ctx, &tictactoev1.GetPuzzleRequest{})
+}
+
+// SubmitPuzzleSolution submits the first move of a puzzle's solution.
+func (c *Client) SubmitPuzzleSolution(ctx context.Context, puzzleID string, position int32) (*tictactoev1.PuzzleResult, error) {
+	return c.api.SubmitPuzzleSolution(ctx, &tictactoev1.SubmitPuzzleSolutionRequest{
+		PuzzleId: puzzleID,
+		Position: position,
+	})
+}
+
+// ExportGame returns a finished game in record notation.
+func (c *Client) ExportGame(ctx context.Context, gameID string) (string, error) {
+	resp, err := c.api.ExportGame(ctx, &tictactoev1.ExportGameRequest{GameId: gameID})
+	if err != nil {
+		return "", err
+	}
+	return resp.Text, nil
+}
+
+// ImportGame checks a game given in record notation against the rules and
+// returns it as a finished game. The server doesn't keep it.
+func (c *Client) ImportGame(ctx context.Context, text string) (*tictactoev1.GameData, error) {
+	return c.api.ImportGame(ctx, &tictactoev1.ImportGameRequest{Text: text})
+}
+
+// RenderGame draws a game as a PNG or an animated GIF.
+func (c *Client) RenderGame(ctx context.Context, req *tictactoev1.RenderGameRequest) (*tictactoev1.RenderedImage, error) {
+	return c.api.RenderGame(ctx, req)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// LinkScheme is the URL scheme of invite links, tictactoe://join/<code>.
+const LinkScheme = "tictactoe"
+
+// Length of the server's join codes
+const joinCodeLength = 6
+
+// JoinLink returns the invite link for a join code.
+func JoinLink(joinCode string) string {
+	return LinkScheme + "://join/" + joinCode
+}
+
+// JoinTarget is a game to join, read from what a player pasted or opened.
+type JoinTarget struct {
+	GameID   string
+	JoinCode string
+}
+
+// ParseJoinTarget accepts a game id, a join code, a tictactoe://join/<code>
+// link, or an invite link of the browser client.
+func ParseJoinTarget(s string) (JoinTarget, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return JoinTarget{}, errors.New("enter a game id, join code or link")
+	}
+
+	u, err := url.Parse(s)
+	if err == nil && u.Scheme == LinkScheme {
+		// "join" is parsed as the host of tictactoe://join/<code>
+		if u.Host != "join" || path.Base(u.Path) == "/" || path.Base(u.Path) == "." {
+			return JoinTarget{}, errors.New("unknown link " + s)
+		}
+		return JoinTarget{JoinCode: path.Base(u.Path)}, nil
+	}
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		// Links of the browser client carry the join code when they have one
+		if code := u.Query().Get("code"); code != "" {
+			return JoinTarget{JoinCode: code}, nil
+		}
+		return JoinTarget{GameID: path.Base(u.Path)}, nil
+	}
+
+	if code := strings.NewReplacer("-", "", " ", "").Replace(s); len(code) == joinCodeLength {
+		return JoinTarget{JoinCode: code}, nil
+	}
+	return JoinTarget{GameID: s}, nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+)
+
+// NotificationStream delivers the notifications of the logged in player.
+type NotificationStream struct {
+	notifications chan *tictactoev1.Notification
+	errs          chan error
+	err           error
+}
+
+// Notifications returns incoming notifications. The channel is closed when
+// the stream ends.
+func (n *NotificationStream) Notifications() <-chan *tictactoev1.Notification {
+	return n.notifications
+}
+
+// Errors reports broken streams that are being reconnected. Errors are dropped
+// while the previous one has not been read.
+func (n *NotificationStream) Errors() <-chan error {
+	return n.errs
+}
+
+// Err returns why the stream ended, once Notifications is closed. It is nil
+// when the context was cancelled.
+func (n *NotificationStream) Err() error {
+	return n.err
+}
+
+// ChallengePlayer invites a player to a game. Bot and password of the
+// settings are not used, as both seats are filled when it is accepted.
+func (c *Client) ChallengePlayer(ctx context.Context, playerID string, settings *tictactoev1.CreateGameRequest) (*tictactoev1.Challenge, error) {
+	return c.api.ChallengePlayer(ctx, &tictactoev1.ChallengePlayerRequest{PlayerId: playerID, Settings: settings})
+}
+
+// AcceptChallenge starts the game of a received challenge.
+func (c *Client) AcceptChallenge(ctx context.Context, challengeID string) (*tictactoev1.GameData, error) {
+	return c.api.AcceptChallenge(ctx, &tictactoev1.AcceptChallengeRequest{ChallengeId: challengeID})
+}
+
+// DeclineChallenge declines a received challenge or withdraws a sent one.
+func (c *Client) DeclineChallenge(ctx context.Context, challengeID string) (*tictactoev1.Challenge, error) {
+	return c.api.DeclineChallenge(ctx, &tictactoev1.DeclineChallengeRequest{ChallengeId: challengeID})
+}
+
+// SubscribeNotifications follows the player's notifications until ctx is
+// cancelled, reconnecting like Subscribe. Pending challenges are delivered
+// again after a reconnect, but not twice in a row.
+func (c *Client) SubscribeNotifications(ctx context.Context) *NotificationStream {
+	n := &NotificationStream{
+		notifications: make(chan *tictactoev1.Notification),
+		errs:          make(chan error, 1),
+	}
+	go func() {
+		defer close(n.notifications)
+		received := make(map[string]bool)
+		n.err = c.keepAlive(ctx, n.errs, func(reset func()) error {
+			stream, err := c.api.GetNotifications(ctx, &tictactoev1.NotificationsRequest{})
+			if err != nil {
+				return err
+			}
+			for {
+				notification, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				reset()
+				if notification.Type == tictactoev1.NotificationType_CHALLENGE_RECEIVED {
+					if received[notification.Challenge.GetChallengeId()] {
+						continue
+					}
+					received[notification.Challenge.GetChallengeId()] = true
+				}
+				select {
+				case n.notifications <- notification:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		})
+	}()
+	return n
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Subscription delivers the updates of a game.
+type Subscription struct {
+	updates chan *tictactoev1.GameData
+	errs    chan error
+	err     error
+}
+
+// Updates returns the game states in the order they were published. The
+// channel is closed when the subscription ends.
+func (s *Subscription) Updates() <-chan *tictactoev1.GameData {
+	return s.updates
+}
+
+// Errors reports broken streams that are being reconnected. Errors are dropped
+// while the previous one has not been read.
+func (s *Subscription) Errors() <-chan error {
+	return s.errs
+}
+
+// Err returns why the subscription ended, once Updates is closed. It is nil
+// when the context was cancelled or the server ended the stream.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Subscribe follows a game until ctx is cancelled. Broken streams are
+// reconnected with exponential backoff, and updates seen before a reconnect
+// are not delivered twice.
+func (c *Client) Subscribe(ctx context.Context, gameID string) *Subscription {
+	s := &Subscription{
+		updates: make(chan *tictactoev1.GameData),
+		errs:    make(chan error, 1),
+	}
+	go c.follow(ctx, gameID, s)
+	return s
+}
+
+func (c *Client) follow(ctx context.Context, gameID string, s *Subscription) {
+	defer close(s.updates)
+
+	version := int64(-1)
+	s.err = c.keepAlive(ctx, s.errs, func(reset func()) error {
+		err := c.stream(ctx, gameID, func(update *tictactoev1.GameData) bool {
+			reset()
+			if update.Version != 0 && update.Version <= version {
+				return true
+			}
+			version = update.Version
+			select {
+			case s.updates <- update:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if errors.Is(err, io.EOF) {
+			// The game is over
+			return nil
+		}
+		return err
+	})
+}
+
+// keepAlive calls open until it returns nil, ctx is done or it fails with an
+// error that reconnecting cannot fix, which is returned. Other errors are
+// reported on errs and retried with exponential backoff, which open resets
+// by calling reset once it receives something.
+func (c *Client) keepAlive(ctx context.Context, errs chan error, open func(reset func()) error) error {
+	backoff := c.opts.minBackoff
+	reset := func() { backoff = c.opts.minBackoff }
+	for {
+		err := open(reset)
+		switch {
+		case err == nil || ctx.Err() != nil:
+			return nil
+		case permanent(err):
+			return err
+		}
+
+		select {
+		case errs <- err:
+		default:
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil
+		}
+		backoff = min(2*backoff, c.opts.maxBackoff)
+	}
+}
+
+// stream receives updates until the stream breaks or deliver returns false.
+func (c *Client) stream(ctx context.Context, gameID string, deliver func(*tictactoev1.GameData) bool) error {
+	stream, err := c.api.GetGameState(ctx, &tictactoev1.GameRequest{GameId: gameID})
+	if err != nil {
+		return err
+	}
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if !deliver(update) {
+			return ctx.Err()
+		}
+	}
+}
+
+// permanent reports errors that reconnecting cannot fix.
+func permanent(err error) bool {
+	switch status.Code(err) {
+	case codes.Canceled, codes.NotFound, codes.InvalidArgument, codes.Unauthenticated, codes.PermissionDenied, codes.Unimplemented:
+		return true
+	}
+	return false
+}
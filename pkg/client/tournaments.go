@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+
+	tictactoev1 "TicTacToe/api/tictactoe"
+)
+
+// StandingsStream delivers the standings of a tournament.
+type StandingsStream struct {
+	updates chan *tictactoev1.Standings
+	errs    chan error
+	err     error
+}
+
+// Updates returns the current standings, then new standings after every
+// change. The channel is closed when the stream ends.
+func (s *StandingsStream) Updates() <-chan *tictactoev1.Standings {
+	return s.updates
+}
+
+// Errors reports broken streams that are being reconnected. Errors are dropped
+// while the previous one has not been read.
+func (s *StandingsStream) Errors() <-chan error {
+	return s.errs
+}
+
+// Err returns why the stream ended, once Updates is closed. It is nil when
+// the context was cancelled.
+func (s *StandingsStream) Err() error {
+	return s.err
+}
+
+// CreateTournament creates a tournament open for registration, with the
+// logged in player as its creator. A tournament with StartsAt set starts by
+// itself, and registered players get TOURNAMENT_STARTING shortly before. With
+// NoShowMinutes set, players who haven't joined their game by then get
+// TOURNAMENT_FORFEIT_WARNING halfway, and forfeit the match at the deadline.
+func (c *Client) CreateTournament(ctx context.Context, req *tictactoev1.CreateTournamentRequest) (*tictactoev1.Tournament, error) {
+	return c.api.CreateTournament(ctx, req)
+}
+
+// ListTournaments returns all tournaments, newest first, without their matches.
+func (c *Client) ListTournaments(ctx context.Context) ([]*tictactoev1.Tournament, error) {
+	resp, err := c.api.ListTournaments(ctx, &tictactoev1.ListTournamentsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tournaments, nil
+}
+
+// RegisterForTournament registers the player for a tournament that hasn't
+// started, while its registration window is open.
+func (c *Client) RegisterForTournament(ctx context.Context, tournamentID string) (*tictactoev1.Tournament, error) {
+	return c.api.RegisterForTournament(ctx, &tictactoev1.RegisterForTournamentRequest{TournamentId: tournamentID})
+}
+
+// StartTournament starts a tournament created by the player. The players are
+// notified of each of their games with TOURNAMENT_GAME_READY.
+func (c *Client) StartTournament(ctx context.Context, tournamentID string) (*tictactoev1.Tournament, error) {
+	return c.api.StartTournament(ctx, &tictactoev1.StartTournamentRequest{TournamentId: tournamentID})
+}
+
+// GetStandings returns a tournament with its matches and standings.
+func (c *Client) GetStandings(ctx context.Context, tournamentID string) (*tictactoev1.Standings, error) {
+	return c.api.GetStandings(ctx, &tictactoev1.GetStandingsRequest{TournamentId: tournamentID})
+}
+
+// SubscribeStandings follows the standings of a tournament until ctx is
+// cancelled, reconnecting like Subscribe.
+func (c *Client) SubscribeStandings(ctx context.Context, tournamentID string) *StandingsStream {
+	s := &StandingsStream{
+		updates: make(chan *tictactoev1.Standings),
+		errs:    make(chan error, 1),
+	}
+	go func() {
+		defer close(s.updates)
+		s.err = c.keepAlive(ctx, s.errs, func(reset func()) error {
+			stream, err := c.api.SubscribeStandings(ctx, &tictactoev1.SubscribeStandingsRequest{TournamentId: tournamentID})
+			if err != nil {
+				return err
+			}
+			for {
+				standings, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				reset()
+				select {
+				case s.updates <- standings:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		})
+	}()
+	return s
+}
@@ -0,0 +1,15 @@
+// Package resources embeds the artwork and sounds shared by the client and the server.
+package resources
+
+import _ "embed"
+
+var (
+	//go:embed x.png
+	XImage []byte
+	//go:embed o.png
+	OImage []byte
+	//go:embed move.wav
+	MoveSound []byte
+	//go:embed button.wav
+	ButtonSound []byte
+)